@@ -2,12 +2,17 @@ package agent
 
 import (
 	"fmt"
+	"github.com/a-grasso/deprec/bots"
 	"github.com/a-grasso/deprec/cache"
 	"github.com/a-grasso/deprec/configuration"
 	"github.com/a-grasso/deprec/cores"
 	"github.com/a-grasso/deprec/extraction"
+	"github.com/a-grasso/deprec/knowledgebase"
 	"github.com/a-grasso/deprec/model"
+	"github.com/a-grasso/deprec/notices"
+	"github.com/a-grasso/deprec/successors"
 	"strings"
+	"time"
 )
 
 type Result struct {
@@ -15,6 +20,20 @@ type Result struct {
 	Core            model.Core
 	Recommendations model.RecommendationDistribution
 	DataSources     []string
+	Successors      []model.Successor
+	LineSupport     *model.LineSupport
+	Knowledge       *model.Knowledge
+	DecisionReason  string
+	BotShare        float64
+	Notices         []model.DeprecationNotice
+	Statements      []model.Statement
+	Timings         Timings
+	Errors          []string
+}
+
+type Timings struct {
+	Extraction time.Duration
+	Evaluation time.Duration
 }
 
 func (ar *Result) UsedFirstLevelCores() string {
@@ -53,7 +72,7 @@ func (ar *Result) ToString() string {
 
 func (ar *Result) TopRecommendation() model.Recommendation {
 
-	if ar.Core.IsInconclusive() {
+	if ar.Core.IsInconclusive() && (ar.Knowledge == nil || !ar.Knowledge.Override) {
 		return model.Inconclusive
 	}
 
@@ -76,36 +95,86 @@ type Agent struct {
 	Dependency model.Dependency
 	Config     configuration.Configuration
 	DataModel  model.DataModel
+
+	// KnowledgeBase is loaded once per run and shared by its agents, agents without one skip the lookup
+	KnowledgeBase *knowledgebase.KnowledgeBase
 }
 
 func NewAgent(dependency model.Dependency, configuration configuration.Configuration) *Agent {
-	agent := Agent{Dependency: dependency, DataModel: model.DataModel{}, Config: configuration}
+	agent := Agent{Dependency: dependency, DataModel: model.DataModel{Dependency: dependency}, Config: configuration}
 	return &agent
 }
 
 func (agent *Agent) Run(cache *cache.Cache) Result {
-	dataSources := agent.Extraction(cache)
+	start := time.Now()
+
+	dataSources, errs := agent.Extraction(cache)
+
+	extracted := time.Now()
 
 	result := agent.CombinationAndConclusion()
 
+	recommendations := result.Recommend()
+
+	botShare, _ := cores.BotShare(agent.DataModel)
+
+	var decisionReason string
+	if knowledge := agent.DataModel.Knowledge; knowledge != nil {
+		decisionReason = knowledge.DecisionReason()
+
+		// a curated verdict in override mode replaces whatever the cores concluded
+		if knowledge.Override {
+			recommendations = model.RecommendationDistribution{
+				model.NoConcerns:        0,
+				model.NoImmediateAction: 0,
+				model.Watchlist:         0,
+				model.DecisionMaking:    0,
+			}
+			recommendations[knowledge.Verdict.Recommendation()] = 1
+		}
+	}
+
 	return Result{
 		Dependency:      agent.Dependency,
 		Core:            result,
-		Recommendations: result.Recommend(),
+		Recommendations: recommendations,
 		DataSources:     dataSources,
+		Successors:      successors.Detect(agent.Dependency, agent.DataModel),
+		LineSupport:     agent.DataModel.LineSupport,
+		Knowledge:       agent.DataModel.Knowledge,
+		DecisionReason:  decisionReason,
+		BotShare:        botShare,
+		Notices:         notices.NewDetector(agent.Config.Marking).Detect(agent.DataModel),
+		Statements:      result.AllStatements(),
+		Timings:         Timings{Extraction: extracted.Sub(start), Evaluation: time.Since(extracted)},
+		Errors:          errs,
 	}
 }
 
-func (agent *Agent) Extraction(cache *cache.Cache) []string {
+type sourceExtractor interface {
+	Extract(dataModel *model.DataModel) error
+}
+
+// Extraction returns the data sources that were used and the errors of those that could not be set up or queried
+func (agent *Agent) Extraction(cache *cache.Cache) ([]string, []string) {
 
 	var dataSources []string
+	var errs []string
 
-	if vcs, exists := agent.Dependency.ExternalReferences[model.VCS]; exists && strings.Contains(vcs, "github") {
-		extractor, err := extraction.NewGitHubExtractor(agent.Dependency, agent.Config.GitHub, cache)
-		if err == nil {
-			extractor.Extract(&agent.DataModel)
-			dataSources = append(dataSources, "github")
+	failed := func(source string, err error) {
+		errs = append(errs, fmt.Sprintf("%s: %s", source, err))
+	}
+
+	extract := func(source string, e sourceExtractor) {
+		if err := e.Extract(&agent.DataModel); err != nil {
+			failed(source, err)
+			return
 		}
+		dataSources = append(dataSources, source)
+	}
+
+	if extractor, err := extraction.NewKnowledgeBaseExtractor(agent.Dependency, agent.KnowledgeBase); err == nil {
+		extract("knowledgebase", extractor)
 	}
 
 	purl := agent.Dependency.PackageURL
@@ -113,24 +182,57 @@ func (agent *Agent) Extraction(cache *cache.Cache) []string {
 	if purl != "" {
 		extractor, err := extraction.NewOSSIndexExtractor(agent.Dependency, agent.Config.OSSIndex, cache)
 		if err == nil {
-			extractor.Extract(&agent.DataModel)
-			dataSources = append(dataSources, "ossindex")
+			extract("ossindex", extractor)
+		} else {
+			failed("ossindex", err)
+		}
+
+		if extractor, err := extraction.NewGitHubAdvisoryExtractor(agent.Dependency, agent.Config.GitHub, cache); err == nil {
+			extract("advisories", extractor)
+		}
+	}
+
+	if strings.HasPrefix(purl, "pkg:npm/") {
+		extractor, err := extraction.NewNPMExtractor(agent.Dependency, cache)
+		if err == nil {
+			extract("npm", extractor)
+		} else {
+			failed("npm", err)
 		}
 	}
 
-	if sha1, exists := agent.Dependency.Hashes[model.SHA1]; exists && sha1 != "" && strings.Contains(purl, "maven") {
-		extraction.NewMavenCentralExtractor(agent.Dependency, cache).Extract(&agent.DataModel)
-		dataSources = append(dataSources, "mavencentral")
+	if strings.HasPrefix(purl, "pkg:maven/") {
+		extract("mavencentral", extraction.NewMavenCentralExtractor(agent.Dependency, agent.Config.MavenCentral, cache))
 	}
 
-	return dataSources
+	// registries go first, the scm of an artifact tells which directory of a monorepo to look at
+	if vcs, exists := agent.Dependency.ExternalReferences[model.VCS]; exists && strings.Contains(vcs, "github") {
+		extractor, err := extraction.NewGitHubExtractor(agent.Dependency, agent.Config.GitHub, cache)
+		if err == nil {
+			extract("github", extractor)
+		} else {
+			failed("github", err)
+		}
+
+		extract("scorecard", extraction.NewScorecardExtractor(agent.Dependency, agent.Config.Scorecard, cache))
+	}
+
+	if extractor, err := extraction.NewEndOfLifeExtractor(agent.Dependency, agent.Config.EndOfLife, cache); err == nil {
+		extract("endoflife", extractor)
+	}
+
+	bots.NewDetector(agent.Config.Bots).Classify(&agent.DataModel)
+
+	return dataSources, errs
 }
 
 func (agent *Agent) CombinationAndConclusion() model.Core {
 
 	cr := model.NewCore(model.CombCon)
 
-	if agent.DataModel.Repository == nil && agent.DataModel.Distribution == nil {
+	agent.DataModel.LineSupport = cores.LineSupport(agent.DataModel, agent.Config.Marking)
+
+	if agent.DataModel.Repository == nil && agent.DataModel.Distribution == nil && agent.DataModel.Lifecycle == nil && agent.DataModel.Knowledge == nil {
 		return *cr
 	}
 
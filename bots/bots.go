@@ -0,0 +1,133 @@
+package bots
+
+import (
+	"github.com/a-grasso/deprec/configuration"
+	"github.com/a-grasso/deprec/logging"
+	"github.com/a-grasso/deprec/model"
+	"regexp"
+)
+
+var defaultLoginPatterns = []string{
+	`\[bot\]$`,
+	`-bot$`,
+	`^dependabot`,
+	`^renovate`,
+	`^github-actions`,
+	`^greenkeeper`,
+	`^snyk-`,
+	`^pyup`,
+	`^gitter-badger$`,
+	`^allcontributors`,
+	`^codecov`,
+}
+
+var defaultMessagePatterns = []string{
+	`^chore\(deps(-dev)?\)`,
+	`^(?i)bump \S+ from \S+ to \S+`,
+	`^(?i)update dependency `,
+	`^(?i)\[maven-release-plugin\]`,
+	`^(chore|ci)\(release\)`,
+}
+
+// fixes and builds of dependencies are also written by hand, their messages only count for commits of a bot
+var defaultBotMessagePatterns = []string{
+	`^(build|fix)\(deps(-dev)?\)`,
+}
+
+// Detector recognises automated accounts by login and automated commits by their message,
+// accounts github reports as bots are already marked during extraction
+type Detector struct {
+	logins      []*regexp.Regexp
+	messages    []*regexp.Regexp
+	botMessages []*regexp.Regexp
+}
+
+// NewDetector extends the default patterns with the configured ones, invalid patterns are skipped
+func NewDetector(config configuration.Bots) *Detector {
+	return &Detector{
+		logins:      compile(append(append([]string{}, defaultLoginPatterns...), config.LoginPatterns...)),
+		messages:    compile(append(append([]string{}, defaultMessagePatterns...), config.MessagePatterns...)),
+		botMessages: compile(defaultBotMessagePatterns),
+	}
+}
+
+func compile(patterns []string) []*regexp.Regexp {
+
+	var result []*regexp.Regexp
+
+	for _, pattern := range patterns {
+		compiled, err := regexp.Compile("(?i)" + pattern)
+		if err != nil {
+			logging.SugaredLogger.Warnf("skipping invalid bot pattern '%s' : %s", pattern, err)
+			continue
+		}
+		result = append(result, compiled)
+	}
+
+	return result
+}
+
+func (d *Detector) IsBotLogin(login string) bool {
+	return login != "" && matchesAny(d.logins, login)
+}
+
+func (d *Detector) IsBotCommit(commit model.Commit) bool {
+
+	if commit.Bot || d.IsBotLogin(commit.Author) || d.IsBotLogin(commit.AuthorName) || matchesAny(d.messages, commit.Message) {
+		return true
+	}
+
+	return d.IsBotLogin(commit.Committer) && matchesAny(d.botMessages, commit.Message)
+}
+
+func matchesAny(patterns []*regexp.Regexp, s string) bool {
+	for _, pattern := range patterns {
+		if pattern.MatchString(s) {
+			return true
+		}
+	}
+	return false
+}
+
+// Classify marks automated commits, contributors, issues, comments and pull requests of the data model
+func (d *Detector) Classify(m *model.DataModel) {
+
+	repository := m.Repository
+	if repository == nil {
+		return
+	}
+
+	for i, commit := range repository.Commits {
+		repository.Commits[i].Bot = d.IsBotCommit(commit)
+	}
+
+	for i, contributor := range repository.Contributors {
+		repository.Contributors[i].Bot = contributor.Bot || d.IsBotLogin(contributor.Name)
+	}
+
+	for _, issues := range [][]model.Issue{repository.Issues, repository.PullRequestIssues} {
+		for i := range issues {
+			d.classifyIssue(&issues[i])
+		}
+	}
+
+	for i, pr := range repository.PullRequests {
+		repository.PullRequests[i].Bot = pr.Bot || d.IsBotLogin(pr.Author)
+	}
+
+	if pr := repository.OldestOpenPullRequest; pr != nil {
+		pr.Bot = pr.Bot || d.IsBotLogin(pr.Author)
+	}
+}
+
+// classifyIssue also recomputes the first responses, as replies of bots are no responses
+func (d *Detector) classifyIssue(issue *model.Issue) {
+
+	issue.Bot = issue.Bot || d.IsBotLogin(issue.Author)
+
+	for i, contribution := range issue.Contributions {
+		issue.Contributions[i].Bot = contribution.Bot || d.IsBotLogin(contribution.Author)
+	}
+
+	issue.UpdateResponses()
+}
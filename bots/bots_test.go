@@ -0,0 +1,69 @@
+package bots
+
+import (
+	"github.com/a-grasso/deprec/configuration"
+	"github.com/a-grasso/deprec/model"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestIsBotLogin(t *testing.T) {
+
+	detector := NewDetector(configuration.Bots{LoginPatterns: []string{`^release-automation$`, `(`}})
+
+	assert.True(t, detector.IsBotLogin("dependabot[bot]"))
+	assert.True(t, detector.IsBotLogin("renovate-bot"))
+	assert.True(t, detector.IsBotLogin("github-actions"))
+	assert.True(t, detector.IsBotLogin("release-automation"))
+	assert.False(t, detector.IsBotLogin("garydgregory"))
+	assert.False(t, detector.IsBotLogin(""))
+}
+
+func TestIsBotCommit(t *testing.T) {
+
+	detector := NewDetector(configuration.Bots{MessagePatterns: []string{`^automated sync`}})
+
+	assert.True(t, detector.IsBotCommit(model.Commit{Author: "someone", Message: "chore(deps): bump junit to 5.9.2"}))
+	assert.True(t, detector.IsBotCommit(model.Commit{Author: "someone", Message: "Bump jackson-databind from 2.14.1 to 2.14.2"}))
+	assert.True(t, detector.IsBotCommit(model.Commit{Author: "someone", Message: "Automated sync of translations"}))
+	assert.True(t, detector.IsBotCommit(model.Commit{Author: "someone", Bot: true}))
+	assert.False(t, detector.IsBotCommit(model.Commit{Author: "someone", Message: "Fix NPE when bumping the counter"}))
+	assert.False(t, detector.IsBotCommit(model.Commit{Author: "someone", Message: "[skip ci] Update the changelog"}))
+
+	assert.False(t, detector.IsBotCommit(model.Commit{Author: "someone", Committer: "someone", Message: "fix(deps): pin guava to 31.1"}))
+	assert.False(t, detector.IsBotCommit(model.Commit{Author: "someone", Message: "build(deps): drop the unused commons-io"}))
+	assert.True(t, detector.IsBotCommit(model.Commit{Author: "someone", Committer: "renovate[bot]", Message: "fix(deps): update guava to 31.1"}))
+	assert.True(t, detector.IsBotCommit(model.Commit{Author: "dependabot[bot]", Message: "build(deps): bump commons-io from 2.11.0 to 2.13.0"}))
+}
+
+func TestClassifyRecomputesResponses(t *testing.T) {
+
+	created := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	botReply := created.Add(time.Hour)
+	maintainerReply := created.Add(48 * time.Hour)
+
+	issue := model.Issue{
+		Author:       "reporter",
+		CreationTime: created,
+		Contributions: []model.IssueContribution{
+			{Time: botReply, Author: "stale[bot]", AuthorAssociation: "NONE"},
+			{Time: maintainerReply, Author: "maintainer", AuthorAssociation: "MEMBER"},
+		},
+	}
+	issue.UpdateResponses()
+	assert.Equal(t, botReply, *issue.FirstResponse)
+
+	m := &model.DataModel{Repository: &model.Repository{
+		Issues:  []model.Issue{issue},
+		Commits: []model.Commit{{Author: "dependabot[bot]"}, {Author: "maintainer"}},
+	}}
+
+	NewDetector(configuration.Bots{}).Classify(m)
+
+	classified := m.Repository.Issues[0]
+	assert.True(t, classified.Contributions[0].Bot)
+	assert.Equal(t, maintainerReply, *classified.FirstResponse)
+	assert.Equal(t, maintainerReply, *classified.FirstMaintainerResponse)
+	assert.Len(t, m.Repository.SelectCommits(false), 1)
+}
@@ -184,6 +184,62 @@ func FetchBatchQuery[T any](ctx context.Context, coll *Collection, f func() (map
 	return values, nil
 }
 
+// incrementalOverlap is how far before the newest cached object a refresh starts, as objects do not always show up in timestamp order,
+// e.g. commits of a merged branch keep the date they were committed on
+const incrementalOverlap = 30 * 24 * time.Hour
+
+// FetchIncremental serves the cached objects, but asks f for objects since shortly before the newest cached one and appends
+// those not cached yet, told apart by key
+func FetchIncremental[T any](ctx context.Context, coll *Collection, f func(since *time.Time) ([]T, error), timestamp func(T) time.Time, key func(T) string) ([]T, error) {
+
+	cachedObjects := checkCache[T](coll)
+	if cachedObjects == nil {
+		logging.SugaredLogger.Debugf("EMPTY CACHE | consuming API for collection '%s' of database '%s'", coll.Name(), coll.Database().Name())
+
+		objects, err := f(nil)
+		if err != nil {
+			return nil, err
+		}
+
+		updateCache[T](ctx, objects, coll)
+
+		return objects, nil
+	}
+
+	var newest time.Time
+	cached := make(map[string]bool, len(cachedObjects))
+	for _, object := range cachedObjects {
+		if t := timestamp(object); t.After(newest) {
+			newest = t
+		}
+		cached[key(object)] = true
+	}
+
+	since := newest.Add(-incrementalOverlap)
+
+	logging.SugaredLogger.Debugf("CACHE HIT | refreshing collection '%s' of database '%s' since %s", coll.Name(), coll.Database().Name(), since)
+
+	objects, err := f(&since)
+	if err != nil {
+		logging.SugaredLogger.Warnf("could not refresh collection '%s' of database '%s', serving cache: %s", coll.Name(), coll.Database().Name(), err)
+		return cachedObjects, nil
+	}
+
+	var fresh []T
+	for _, object := range objects {
+		if k := key(object); !cached[k] {
+			cached[k] = true
+			fresh = append(fresh, object)
+		}
+	}
+
+	if len(fresh) != 0 {
+		updateCache[T](ctx, fresh, coll)
+	}
+
+	return append(cachedObjects, fresh...), nil
+}
+
 func FetchAsync[T any](ctx context.Context, coll *Collection, f func() ([]T, *github.Response, error)) ([]T, error) {
 
 	cachedObjects := checkCache[T](coll)
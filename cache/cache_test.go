@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
 func TestCheckCacheSingle(t *testing.T) {
@@ -367,3 +368,48 @@ func TestEmptyCollectionExistsTrue(t *testing.T) {
 
 	assert.True(t, emptyCollectionExists(context.TODO(), collection))
 }
+
+func TestFetchIncremental(t *testing.T) {
+	t.Cleanup(CleanDatabase)
+
+	db := cache.Database("test-collection")
+	collection := &Collection{
+		name:       "test-fetch-incremental",
+		db:         &Database{name: "TestD", Database: db},
+		Collection: db.Collection("test-fetch-incremental"),
+	}
+
+	type TestObject struct {
+		One  string
+		Time time.Time
+	}
+
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	second := first.AddDate(0, 1, 0)
+
+	timestamp := func(o TestObject) time.Time { return o.Time }
+	key := func(o TestObject) string { return o.One }
+
+	var requestedSince []*time.Time
+	f := func(since *time.Time) ([]TestObject, error) {
+		requestedSince = append(requestedSince, since)
+		if since == nil {
+			return []TestObject{{One: "first", Time: first}}, nil
+		}
+		// a merged branch brings an object older than the newest cached one
+		return []TestObject{{One: "second", Time: second}, {One: "first", Time: first}, {One: "merged", Time: first.Add(-time.Hour)}}, nil
+	}
+
+	objects, err := FetchIncremental[TestObject](context.TODO(), collection, f, timestamp, key)
+	assert.Nil(t, err)
+	assert.Len(t, objects, 1)
+
+	objects, err = FetchIncremental[TestObject](context.TODO(), collection, f, timestamp, key)
+	assert.Nil(t, err)
+	assert.Len(t, objects, 3)
+
+	assert.Nil(t, requestedSince[0])
+	assert.Equal(t, first.Add(-incrementalOverlap), requestedSince[1].UTC())
+
+	assert.Len(t, checkCache[TestObject](collection), 3)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/a-grasso/deprec/logging"
 	"github.com/joho/godotenv"
 	"os"
+	"strings"
 )
 
 func Load(configFilePath, envFilePath string) (*Configuration, error) {
@@ -23,8 +24,12 @@ func Load(configFilePath, envFilePath string) (*Configuration, error) {
 
 	config := &Configuration{
 		Extraction: Extraction{
-			GitHub:   GitHub{},
-			OSSIndex: OSSIndex{},
+			GitHub:        GitHub{},
+			OSSIndex:      OSSIndex{},
+			MavenCentral:  MavenCentral{},
+			EndOfLife:     EndOfLife{},
+			KnowledgeBase: LocalKnowledgeBase{},
+			Scorecard:     Scorecard{},
 		},
 		Cache: Cache{
 			MongoDB: MongoDB{},
@@ -51,6 +56,22 @@ func Load(configFilePath, envFilePath string) (*Configuration, error) {
 	if !present {
 		logging.Logger.Warn("OSSINDEX_TOKEN environment variable missing!")
 	}
+	// maven central is used as repository and search api unless a proxy is configured
+	config.Extraction.MavenCentral.RepositoryURL = os.Getenv("MAVENCENTRAL_REPOSITORY_URL")
+	config.Extraction.MavenCentral.SearchURL = os.Getenv("MAVENCENTRAL_SEARCH_URL")
+	config.Extraction.MavenCentral.SearchAPI = os.Getenv("MAVENCENTRAL_SEARCH_API")
+
+	// lifecycle data is read from endoflife.date unless a mirror or a local directory of product files is configured
+	config.Extraction.EndOfLife.URL = os.Getenv("ENDOFLIFE_URL")
+	config.Extraction.EndOfLife.Directory = os.Getenv("ENDOFLIFE_DIRECTORY")
+	config.Extraction.EndOfLife.Products = parseProducts(os.Getenv("ENDOFLIFE_PRODUCTS"))
+
+	config.Extraction.KnowledgeBase.Directory = os.Getenv("KNOWLEDGEBASE_DIRECTORY")
+
+	// scorecards are read from the public api unless a mirror or a directory of scorecard cli results is configured
+	config.Extraction.Scorecard.URL = os.Getenv("SCORECARD_URL")
+	config.Extraction.Scorecard.Directory = os.Getenv("SCORECARD_DIRECTORY")
+
 	config.Cache.MongoDB.URI, present = os.LookupEnv("CACHE_MONGODB_URI")
 	if !present {
 		logging.Logger.Warn("CACHE_MONGODB_URI environment variable missing!")
@@ -66,3 +87,19 @@ func Load(configFilePath, envFilePath string) (*Configuration, error) {
 
 	return config, nil
 }
+
+// parseProducts reads mappings like 'pkg:maven/org.springframework/*=spring-framework,pkg:npm/vue=vue'
+func parseProducts(value string) map[string]string {
+
+	products := make(map[string]string)
+
+	for _, mapping := range strings.Split(value, ",") {
+		pattern, product, found := strings.Cut(mapping, "=")
+		if !found {
+			continue
+		}
+		products[strings.TrimSpace(pattern)] = strings.TrimSpace(product)
+	}
+
+	return products
+}
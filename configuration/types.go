@@ -9,6 +9,27 @@ type OSSIndex struct {
 	Token    string `json:"Token,omitempty"`
 }
 
+type MavenCentral struct {
+	RepositoryURL string `json:"RepositoryURL,omitempty"`
+	SearchURL     string `json:"SearchURL,omitempty"`
+	SearchAPI     string `json:"SearchAPI,omitempty"`
+}
+
+type EndOfLife struct {
+	URL       string            `json:"URL,omitempty"`
+	Directory string            `json:"Directory,omitempty"`
+	Products  map[string]string `json:"Products,omitempty"`
+}
+
+type LocalKnowledgeBase struct {
+	Directory string `json:"Directory,omitempty"`
+}
+
+type Scorecard struct {
+	URL       string `json:"URL,omitempty"`
+	Directory string `json:"Directory,omitempty"`
+}
+
 type MongoDB struct {
 	Username string `json:"Username,omitempty"`
 	Password string `json:"Password,omitempty"`
@@ -34,12 +55,25 @@ type CoresConfig struct {
 	CoreTeam        CoreTeam        `json:"CoreTeam"`
 	Backup          Backup          `json:"Backup"`
 	Engagement      Engagement      `json:"Engagement"`
+	PullRequests    PullRequests    `json:"PullRequests"`
 	Participation   Participation   `json:"Participation"`
 	Prestige        Prestige        `json:"Prestige"`
 	Licensing       Licensing       `json:"Licensing"`
 	Rivalry         Rivalry         `json:"Rivalry"`
 	ProjectQuality  ProjectQuality  `json:"ProjectQuality"`
 	Marking         Marking         `json:"Marking"`
+	KnowledgeBase   KnowledgeBase   `json:"KnowledgeBase"`
+	Automation      Automation      `json:"Automation"`
+	BuildHealth     BuildHealth     `json:"BuildHealth"`
+	Maintained      Maintained      `json:"Maintained"`
+
+	Bots Bots `json:"Bots"`
+}
+
+// Bots extends the built-in login and commit message patterns used to recognise automated activity
+type Bots struct {
+	LoginPatterns   []string `json:"LoginPatterns,omitempty"`
+	MessagePatterns []string `json:"MessagePatterns,omitempty"`
 }
 
 type CombCon struct {
@@ -57,6 +91,7 @@ type DeityGiven struct {
 	Weights struct {
 		Marking         float64 `json:"Marking,omitempty"`
 		Vulnerabilities float64 `json:"Vulnerabilities,omitempty"`
+		KnowledgeBase   float64 `json:"KnowledgeBase,omitempty"`
 	} `json:"Weights"`
 }
 type Circumstances struct {
@@ -68,16 +103,20 @@ type Circumstances struct {
 }
 type Effort struct {
 	Weights struct {
-		Activity   float64 `json:"Activity,omitempty"`
-		Recentness float64 `json:"Recentness,omitempty"`
-		CoreTeam   float64 `json:"CoreTeam,omitempty"`
+		Activity    float64 `json:"Activity,omitempty"`
+		Recentness  float64 `json:"Recentness,omitempty"`
+		CoreTeam    float64 `json:"CoreTeam,omitempty"`
+		Automation  float64 `json:"Automation,omitempty"`
+		BuildHealth float64 `json:"BuildHealth,omitempty"`
+		Maintained  float64 `json:"Maintained,omitempty"`
 	} `json:"Weights"`
 }
 
 type Support struct {
 	Weights struct {
-		Processing float64 `json:"Processing,omitempty"`
-		Engagement float64 `json:"Engagement,omitempty"`
+		Processing   float64 `json:"Processing,omitempty"`
+		Engagement   float64 `json:"Engagement,omitempty"`
+		PullRequests float64 `json:"PullRequests,omitempty"`
 	} `json:"Weights"`
 }
 
@@ -101,11 +140,31 @@ type Marking struct {
 	AboutKeywords               []string `json:"AboutKeywords,omitempty"`
 	ArtifactDescriptionKeywords []string `json:"ArtifactDescriptionKeywords,omitempty"`
 
+	// NoticePhrases adds deprecation phrases per language, NoticePatterns are regular expressions for any language
+	NoticePhrases  map[string][]string `json:"NoticePhrases,omitempty"`
+	NoticePatterns []string            `json:"NoticePatterns,omitempty"`
+	ReadMeTopLines int                 `json:"ReadMeTopLines,omitempty"`
+
+	LineDepth int `json:"LineDepth,omitempty"`
+	LineLimit int `json:"LineLimit,omitempty"`
+
+	EndOfLifeNotice int `json:"EndOfLifeNotice,omitempty"`
+
 	Weights struct {
 		ReadMe      float64 `json:"ReadMe,omitempty"`
 		About       float64 `json:"About,omitempty"`
 		Archivation float64 `json:"Archivation,omitempty"`
 		Artifact    float64 `json:"Artifact,omitempty"`
+		Moved       float64 `json:"Moved,omitempty"`
+		Relocation  float64 `json:"Relocation,omitempty"`
+		EndOfLine   float64 `json:"EndOfLine,omitempty"`
+		EndOfLife   float64 `json:"EndOfLife,omitempty"`
+	} `json:"Weights"`
+}
+
+type KnowledgeBase struct {
+	Weights struct {
+		Verdict float64 `json:"Verdict,omitempty"`
 	} `json:"Weights"`
 }
 
@@ -115,17 +174,40 @@ type ProjectQuality struct {
 		License      float64 `json:"License,omitempty"`
 		About        float64 `json:"About,omitempty"`
 		AllowForking float64 `json:"AllowForking,omitempty"`
+
+		CodeReview       float64 `json:"CodeReview,omitempty"`
+		CITests          float64 `json:"CITests,omitempty"`
+		SecurityPolicy   float64 `json:"SecurityPolicy,omitempty"`
+		BranchProtection float64 `json:"BranchProtection,omitempty"`
+
+		SecurityPosture float64 `json:"SecurityPosture,omitempty"`
+	} `json:"Weights"`
+}
+
+type Maintained struct {
+	Weights struct {
+		Scorecard float64 `json:"Scorecard,omitempty"`
 	} `json:"Weights"`
 }
 
 type Vulnerabilities struct {
 	Weights struct {
-		CVE float64 `json:"CVE,omitempty"`
+		CVE      float64 `json:"CVE,omitempty"`
+		Advisory float64 `json:"Advisory,omitempty"`
 	} `json:"Weights"`
 }
 type Rivalry struct {
-	Weights struct {
-		IsLatest float64 `json:"IsLatest,omitempty"`
+	MajorsBehindLimit int     `json:"MajorsBehindLimit,omitempty"`
+	MinorsBehindLimit int     `json:"MinorsBehindLimit,omitempty"`
+	LibyearLimit      float64 `json:"LibyearLimit,omitempty"`
+	MajorLineLimit    int     `json:"MajorLineLimit,omitempty"`
+	Weights           struct {
+		IsLatest     float64 `json:"IsLatest,omitempty"`
+		Fork         float64 `json:"Fork,omitempty"`
+		MajorsBehind float64 `json:"MajorsBehind,omitempty"`
+		MinorsBehind float64 `json:"MinorsBehind,omitempty"`
+		Libyear      float64 `json:"Libyear,omitempty"`
+		MajorLine    float64 `json:"MajorLine,omitempty"`
 	} `json:"Weights"`
 }
 
@@ -142,13 +224,26 @@ type Prestige struct {
 		Contributors float64 `json:"Contributors,omitempty"`
 	} `json:"Weights"`
 }
+
+// IssuePopulation decides whether pull requests and issues opened by bots count as issues
+type IssuePopulation struct {
+	PullRequests bool `json:"PullRequests,omitempty"`
+	Bots         bool `json:"Bots,omitempty"`
+}
+
 type Activity struct {
-	Percentile float64 `json:"Percentile,omitempty"`
-	Weights    struct {
+	Percentile                  float64         `json:"Percentile,omitempty"`
+	PublicationTimeframe        int             `json:"PublicationTimeframe,omitempty"`
+	RecentPublicationsThreshold int             `json:"RecentPublicationsThreshold,omitempty"`
+	IssuePopulation             IssuePopulation `json:"IssuePopulation"`
+	ExcludeBots                 bool            `json:"ExcludeBots,omitempty"`
+	Weights                     struct {
 		Commits            float64 `json:"Commits,omitempty"`
 		Releases           float64 `json:"Releases,omitempty"`
 		Issues             float64 `json:"Issues,omitempty"`
 		IssueContributions float64 `json:"IssueContributions,omitempty"`
+		Publications       float64 `json:"Publications,omitempty"`
+		RecentPublications float64 `json:"RecentPublications,omitempty"`
 	} `json:"Weights"`
 }
 
@@ -177,37 +272,83 @@ type Recentness struct {
 	CommitLimit                int     `json:"CommitLimit,omitempty"`
 	ReleaseLimit               int     `json:"ReleaseLimit,omitempty"`
 	TimeframePercentileCommits float64 `json:"TimeframePercentileCommits,omitempty"`
+	CadenceTolerance           float64 `json:"CadenceTolerance,omitempty"`
+	ExcludeBots                bool    `json:"ExcludeBots,omitempty"`
 	Weights                    struct {
 		MonthsSinceLastCommit         float64 `json:"MonthsSinceLastCommit,omitempty"`
 		AverageMonthsSinceLastCommits float64 `json:"AverageMonthsSinceLastCommits,omitempty"`
 		MonthsSinceLastRelease        float64 `json:"MonthsSinceLastRelease,omitempty"`
+		MonthsSinceLastPublication    float64 `json:"MonthsSinceLastPublication,omitempty"`
+		ReleaseCadence                float64 `json:"ReleaseCadence,omitempty"`
 	} `json:"Weights"`
 }
 
 type Processing struct {
-	ClosingTimeLimit int     `json:"ClosingTimeLimit,omitempty"`
-	BurnPercentile   float64 `json:"BurnPercentile,omitempty"`
-	Weights          struct {
+	ClosingTimeLimit       int             `json:"ClosingTimeLimit,omitempty"`
+	BurnPercentile         float64         `json:"BurnPercentile,omitempty"`
+	FirstResponseDaysLimit int             `json:"FirstResponseDaysLimit,omitempty"`
+	IssuePopulation        IssuePopulation `json:"IssuePopulation"`
+	Weights                struct {
 		AverageClosingTime float64 `json:"AverageClosingTime,omitempty"`
 		Burn               float64 `json:"Burn,omitempty"`
+		FirstResponse      float64 `json:"FirstResponse,omitempty"`
 	} `json:"Weights"`
 }
 
 type Engagement struct {
-	IssueCommentsRatioThreshold float64 `json:"IssueCommentsRatioThreshold,omitempty"`
+	IssueCommentsRatioThreshold float64         `json:"IssueCommentsRatioThreshold,omitempty"`
+	MaintainerResponseDaysLimit int             `json:"MaintainerResponseDaysLimit,omitempty"`
+	IssuePopulation             IssuePopulation `json:"IssuePopulation"`
 	Weights                     struct {
 		IssueCommentsRatio float64 `json:"IssueCommentsRatio,omitempty"`
+		MaintainerResponse float64 `json:"MaintainerResponse,omitempty"`
 	} `json:"Weights"`
 }
+type Automation struct {
+	BotShareLimit float64 `json:"BotShareLimit,omitempty"`
+	Weights       struct {
+		BotShare float64 `json:"BotShare,omitempty"`
+	} `json:"Weights"`
+}
+
+type BuildHealth struct {
+	LastSuccessLimit   int `json:"LastSuccessLimit,omitempty"`
+	FailureStreakLimit int `json:"FailureStreakLimit,omitempty"`
+	Weights            struct {
+		CI            float64 `json:"CI,omitempty"`
+		Tests         float64 `json:"Tests,omitempty"`
+		LastSuccess   float64 `json:"LastSuccess,omitempty"`
+		FailureStreak float64 `json:"FailureStreak,omitempty"`
+	} `json:"Weights"`
+}
+
+type PullRequests struct {
+	MergeTimeDaysLimit int  `json:"MergeTimeDaysLimit,omitempty"`
+	OldestOpenLimit    int  `json:"OldestOpenLimit,omitempty"`
+	IgnoredMinimumDays int  `json:"IgnoredMinimumDays,omitempty"`
+	ExcludeBots        bool `json:"ExcludeBots,omitempty"`
+	Weights            struct {
+		MergeRate       float64 `json:"MergeRate,omitempty"`
+		MergeTime       float64 `json:"MergeTime,omitempty"`
+		IgnoredExternal float64 `json:"IgnoredExternal,omitempty"`
+		OldestOpen      float64 `json:"OldestOpen,omitempty"`
+	} `json:"Weights"`
+}
+
 type Backup struct {
-	CompanyThreshold      int     `json:"CompanyThreshold,omitempty"`
-	SponsorThreshold      float64 `json:"SponsorThreshold,omitempty"`
-	OrganizationThreshold float64 `json:"OrganizationThreshold,omitempty"`
-	Weights               struct {
+	CompanyThreshold        int     `json:"CompanyThreshold,omitempty"`
+	SponsorThreshold        float64 `json:"SponsorThreshold,omitempty"`
+	OrganizationThreshold   float64 `json:"OrganizationThreshold,omitempty"`
+	ProjectSponsorThreshold float64 `json:"ProjectSponsorThreshold,omitempty"`
+	Weights                 struct {
 		Companies              float64 `json:"Companies,omitempty"`
 		Sponsors               float64 `json:"Sponsors,omitempty"`
 		Organizations          float64 `json:"Organizations,omitempty"`
 		RepositoryOrganization float64 `json:"RepositoryOrganization,omitempty"`
+
+		FundingLinks       float64 `json:"FundingLinks,omitempty"`
+		ProjectSponsors    float64 `json:"ProjectSponsors,omitempty"`
+		CorporateOwnership float64 `json:"CorporateOwnership,omitempty"`
 	} `json:"Weights"`
 }
 
@@ -215,6 +356,7 @@ type CoreTeam struct {
 	ActiveContributorsPercentile float64 `json:"ActiveContributorsPercentile,omitempty"`
 	ActiveContributorsThreshold  float64 `json:"ActiveContributorsThreshold,omitempty"`
 	CoreTeamStrengthThreshold    float64 `json:"CoreTeamStrengthThreshold,omitempty"`
+	ExcludeBots                  bool    `json:"ExcludeBots,omitempty"`
 	Weights                      struct {
 		ActiveContributors float64 `json:"ActiveContributors,omitempty"`
 		CoreTeamStrength   float64 `json:"CoreTeamStrength,omitempty"`
@@ -222,8 +364,12 @@ type CoreTeam struct {
 }
 
 type Extraction struct {
-	GitHub   GitHub   `json:"GitHub"`
-	OSSIndex OSSIndex `json:"OSSIndex"`
+	GitHub        GitHub             `json:"GitHub"`
+	OSSIndex      OSSIndex           `json:"OSSIndex"`
+	MavenCentral  MavenCentral       `json:"MavenCentral"`
+	EndOfLife     EndOfLife          `json:"EndOfLife"`
+	KnowledgeBase LocalKnowledgeBase `json:"KnowledgeBase"`
+	Scorecard     Scorecard          `json:"Scorecard"`
 }
 
 type Cache struct {
@@ -4,29 +4,41 @@ import (
 	"github.com/a-grasso/deprec/configuration"
 	"github.com/a-grasso/deprec/model"
 	"github.com/a-grasso/deprec/statistics"
+	"github.com/thoas/go-funk"
 )
 
 func Activity(m model.DataModel, config configuration.Activity) model.Core {
 
 	cr := model.NewCore(model.Activity)
 
-	if m.Repository == nil {
-		return *cr
+	percentile := config.Percentile
+
+	if m.Repository != nil {
+		commits := m.Repository.SelectCommits(!config.ExcludeBots)
+		releases := m.Repository.Releases
+		issues := m.Repository.SelectIssues(config.IssuePopulation.PullRequests, config.IssuePopulation.Bots)
+
+		handle(commits, "Commits", model.GitHubSource, config.Weights.Commits, percentile, cr)
+		handle(releases, "Releases", model.GitHubSource, config.Weights.Releases, percentile, cr)
+		handle(issues, "Issues", model.GitHubSource, config.Weights.Issues, percentile, cr)
 	}
 
-	commits := m.Repository.Commits
-	releases := m.Repository.Releases
-	issues := m.Repository.Issues
+	if m.Distribution != nil && m.Distribution.Library != nil {
+		publications := funk.Filter(m.Distribution.Library.Releases, func(r model.Release) bool { return !r.Date.IsZero() }).([]model.Release)
 
-	percentile := config.Percentile
-	handle(commits, config.Weights.Commits, percentile, cr)
-	handle(releases, config.Weights.Releases, percentile, cr)
-	handle(issues, config.Weights.Issues, percentile, cr)
+		handle(publications, "Publications", model.RegistrySource, config.Weights.Publications, percentile, cr)
+
+		if cadence := statistics.AnalyzeCadence(publications, config.PublicationTimeframe); cadence != nil {
+			cr.Measure("Recent publications", model.RegistrySource).
+				Because("%d publications in the last %d months", cadence.RecentReleases, config.PublicationTimeframe).
+				IntakeThreshold(float64(cadence.RecentReleases), float64(config.RecentPublicationsThreshold), config.Weights.RecentPublications)
+		}
+	}
 
 	return *cr
 }
 
-func handle[T statistics.HasTimestamp](count []T, weight float64, percentile float64, cr *model.Core) {
+func handle[T statistics.HasTimestamp](count []T, metric string, source string, weight float64, percentile float64, cr *model.Core) {
 
 	if len(count) == 0 {
 		return
@@ -38,7 +50,7 @@ func handle[T statistics.HasTimestamp](count []T, weight float64, percentile flo
 
 	lpaAverageDiff := analysis.LPAOverAVG()
 
-	cr.Intake(percentileAverageDiff, weight)
+	cr.Measure(metric+": latest over earlier activity", source).Because("%d in total", len(count)).Intake(percentileAverageDiff, weight)
 
-	cr.Intake(lpaAverageDiff, weight)
+	cr.Measure(metric+": latest over average activity", source).Because("%d in total", len(count)).Intake(lpaAverageDiff, weight)
 }
@@ -17,6 +17,10 @@ func DeityGiven(m model.DataModel, c configuration.CoresConfig) model.Core {
 
 	cr.Overtake(vulnerabilities, c.DeityGiven.Weights.Vulnerabilities)
 
+	knowledgeBase := KnowledgeBase(m, c.KnowledgeBase)
+
+	cr.Overtake(knowledgeBase, c.DeityGiven.Weights.KnowledgeBase)
+
 	return *cr
 }
 
@@ -34,6 +38,18 @@ func Effort(m model.DataModel, c configuration.CoresConfig) model.Core {
 	cr.Overtake(activity, c.Effort.Weights.Activity)
 	cr.Overtake(coreTeam, c.Effort.Weights.CoreTeam)
 
+	automation := Automation(m, c.Automation)
+
+	cr.Overtake(automation, c.Effort.Weights.Automation)
+
+	buildHealth := BuildHealth(m, c.BuildHealth)
+
+	cr.Overtake(buildHealth, c.Effort.Weights.BuildHealth)
+
+	maintained := Maintained(m, c.Maintained)
+
+	cr.Overtake(maintained, c.Effort.Weights.Maintained)
+
 	return *cr
 }
 
@@ -82,6 +98,11 @@ func Support(m model.DataModel, c configuration.CoresConfig) model.Core {
 	cr.Overtake(processing, c.Support.Weights.Processing)
 
 	cr.Overtake(engagement, c.Support.Weights.Engagement)
+
+	pullRequests := PullRequests(m, c.PullRequests)
+
+	cr.Overtake(pullRequests, c.Support.Weights.PullRequests)
+
 	return *cr
 }
 
@@ -0,0 +1,31 @@
+package cores
+
+import (
+	"github.com/a-grasso/deprec/configuration"
+	"github.com/a-grasso/deprec/model"
+)
+
+// Automation penalises projects whose commit history is mostly written by bots, e.g. weekly dependency bumps
+func Automation(m model.DataModel, c configuration.Automation) model.Core {
+
+	cr := model.NewCore(model.Automation)
+
+	if share, exists := BotShare(m); exists {
+		cr.Measure("Bot share of commits", model.GitHubSource).IntakeLimit(share, c.BotShareLimit, c.Weights.BotShare)
+	}
+
+	return *cr
+}
+
+// BotShare is the fraction of commits attributed to bots
+func BotShare(m model.DataModel) (float64, bool) {
+
+	if m.Repository == nil || len(m.Repository.Commits) == 0 {
+		return 0, false
+	}
+
+	total := len(m.Repository.Commits)
+	human := len(m.Repository.SelectCommits(false))
+
+	return float64(total-human) / float64(total), true
+}
@@ -14,6 +14,19 @@ func Backup(m model.DataModel, c configuration.Backup) model.Core {
 		return *cr
 	}
 
+	intakeFunding(cr, m.Repository.Funding, c)
+
+	// a verified domain or a company named in the profile both tell that a company stands behind the organization
+	if org := m.Repository.Org; org != nil && (org.Verified || org.Company != "") {
+		ownership := cr.Measure("Corporate organization", model.GitHubSource)
+		if org.Company != "" {
+			ownership.Because("organization '%s' of company '%s'", org.Login, org.Company)
+		} else {
+			ownership.Because("verified organization '%s'", org.Login)
+		}
+		ownership.Intake(model.NC, c.Weights.CorporateOwnership)
+	}
+
 	contributors := m.Repository.Contributors
 
 	if len(contributors) == 0 {
@@ -24,15 +37,31 @@ func Backup(m model.DataModel, c configuration.Backup) model.Core {
 	sponsors := funk.Sum(funk.Map(contributors, func(c model.Contributor) int { return c.Sponsors }))
 	organizations := funk.Sum(funk.Map(contributors, func(c model.Contributor) int { return c.Organizations }))
 
-	cr.IntakeThreshold(float64(len(companies)), float64(c.CompanyThreshold), c.Weights.Companies)
-	cr.IntakeThreshold(sponsors, c.SponsorThreshold, c.Weights.Sponsors)
-	cr.IntakeThreshold(organizations, c.OrganizationThreshold, c.Weights.Organizations)
+	cr.Measure("Contributor companies", model.GitHubSource).IntakeThreshold(float64(len(companies)), float64(c.CompanyThreshold), c.Weights.Companies)
+	cr.Measure("Contributor sponsors", model.GitHubSource).IntakeThreshold(sponsors, c.SponsorThreshold, c.Weights.Sponsors)
+	cr.Measure("Contributor organizations", model.GitHubSource).IntakeThreshold(organizations, c.OrganizationThreshold, c.Weights.Organizations)
 
-	if m.Repository.Org != nil {
-		cr.Intake(model.NC, c.Weights.RepositoryOrganization)
+	if org := m.Repository.Org; org != nil {
+		cr.Measure("Repository organization", model.GitHubSource).Because("owned by '%s'", org.Login).Intake(model.NC, c.Weights.RepositoryOrganization)
 	} else {
-		cr.Intake(model.W, c.Weights.RepositoryOrganization)
+		cr.Measure("Repository organization", model.GitHubSource).Because("owned by a user").Intake(model.W, c.Weights.RepositoryOrganization)
 	}
 
 	return *cr
 }
+
+func intakeFunding(cr *model.Core, funding *model.Funding, c configuration.Backup) {
+
+	if funding == nil {
+		return
+	}
+
+	// most healthy projects are not funded, so only the presence of funding links tells something
+	if len(funding.Links) > 0 {
+		cr.Measure("Funding links", model.GitHubSource).Because("first link %s", funding.Links[0].URL).Intake(model.NC, c.Weights.FundingLinks)
+	}
+
+	if funding.SponsorsListing {
+		cr.Measure("Project sponsors", model.GitHubSource).IntakeThreshold(float64(funding.Sponsors), c.ProjectSponsorThreshold, c.Weights.ProjectSponsors)
+	}
+}
@@ -0,0 +1,27 @@
+package cores
+
+import (
+	"github.com/a-grasso/deprec/configuration"
+	"github.com/a-grasso/deprec/model"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestBackupFunding(t *testing.T) {
+
+	var c configuration.Backup
+	c.Weights.FundingLinks = 1
+	c.Weights.CorporateOwnership = 1
+
+	unfunded := model.DataModel{Repository: &model.Repository{RepositoryData: &model.RepositoryData{Funding: &model.Funding{}}}}
+	assert.Empty(t, Backup(unfunded, c).Statements)
+
+	funded := model.DataModel{Repository: &model.Repository{RepositoryData: &model.RepositoryData{
+		Funding: &model.Funding{Links: []model.FundingLink{{URL: "https://opencollective.com/p"}}},
+		Org:     &model.Organization{Login: "o", Company: "Example Inc."},
+	}}}
+
+	core := Backup(funded, c)
+	assert.Len(t, core.Statements, 2)
+	assert.Equal(t, 2.0, core.NoConcerns)
+}
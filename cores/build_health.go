@@ -0,0 +1,51 @@
+package cores
+
+import (
+	"github.com/a-grasso/deprec/configuration"
+	"github.com/a-grasso/deprec/model"
+	"github.com/a-grasso/deprec/statistics"
+	"strings"
+)
+
+// BuildHealth tells whether the project still builds, judged by its ci setup, tests and recent workflow runs
+func BuildHealth(m model.DataModel, c configuration.BuildHealth) model.Core {
+
+	cr := model.NewCore(model.BuildHealth)
+
+	if m.Repository == nil || m.Repository.CI == nil {
+		return *cr
+	}
+
+	ci := m.Repository.CI
+
+	systems := cr.Measure("CI configured", model.GitHubSource)
+	if len(ci.Systems) > 0 {
+		systems.Because("%s", strings.Join(ci.Systems, ", ")).Intake(model.NC, c.Weights.CI)
+	} else {
+		systems.Intake(model.W, c.Weights.CI)
+	}
+
+	// modules and packages below the root are not searched, so missing tests only count where the layout is plain
+	if len(ci.Tests) > 0 {
+		cr.Measure("Tests", model.GitHubSource).Because("%d found, e.g. %s", len(ci.Tests), ci.Tests[0]).Intake(model.NC, c.Weights.Tests)
+	} else if ci.TestsExpected {
+		cr.Measure("Tests", model.GitHubSource).Because("none found in the sources").Intake(model.W, c.Weights.Tests)
+	}
+
+	if ci.Runs == 0 {
+		return *cr
+	}
+
+	if ci.LastSuccess == nil {
+		cr.Measure("Months since last successful run", model.GitHubSource).Because("none of the last %d runs succeeded", ci.Runs).Intake(model.DM, c.Weights.LastSuccess)
+	} else {
+		monthsSinceSuccess := statistics.CalculateTimeDifference(*ci.LastSuccess, statistics.CustomNow())
+		cr.Measure("Months since last successful run", model.GitHubSource).
+			Because("last success on %s", ci.LastSuccess.Format("2006-01-02")).
+			IntakeLimit(float64(monthsSinceSuccess), float64(c.LastSuccessLimit), c.Weights.LastSuccess)
+	}
+
+	cr.Measure("Failure streak", model.GitHubSource).IntakeLimit(float64(ci.FailureStreak), float64(c.FailureStreakLimit), c.Weights.FailureStreak)
+
+	return *cr
+}
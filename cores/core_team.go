@@ -17,10 +17,10 @@ func CoreTeam(m model.DataModel, c configuration.CoreTeam) model.Core {
 		return *cr
 	}
 
-	contributors := m.Repository.Contributors
-	commits := m.Repository.Commits
+	contributors := m.Repository.SelectContributors(!c.ExcludeBots)
+	commits := m.Repository.SelectCommits(!c.ExcludeBots)
 
-	if contributors == nil {
+	if len(contributors) == 0 {
 		return *cr
 	}
 
@@ -28,15 +28,19 @@ func CoreTeam(m model.DataModel, c configuration.CoreTeam) model.Core {
 	//TODO: Needs overhaul, as too punishing for big projects 50+ contributors (all those with ~2 commits)
 	// old??
 
-	cr.IntakeThreshold(coreTeam, c.CoreTeamStrengthThreshold, c.Weights.CoreTeamStrength)
+	cr.Measure("Core team strength", model.GitHubSource).
+		Because("%d contributors", len(contributors)).
+		IntakeThreshold(coreTeam, c.CoreTeamStrengthThreshold, c.Weights.CoreTeamStrength)
 
-	if commits == nil {
+	if len(commits) == 0 {
 		return *cr
 	}
 
 	active := activeContributorsTotalPercentage(commits, contributors, c.ActiveContributorsPercentile) * 100
 
-	cr.IntakeThreshold(active, c.ActiveContributorsThreshold, c.Weights.ActiveContributors)
+	cr.Measure("Active contributors (%)", model.GitHubSource).
+		Because("%d commits", len(commits)).
+		IntakeThreshold(active, c.ActiveContributorsThreshold, c.Weights.ActiveContributors)
 
 	// TODO: in relation zu timeline setzen?
 	// old??
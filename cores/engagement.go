@@ -4,6 +4,7 @@ import (
 	"github.com/a-grasso/deprec/configuration"
 	"github.com/a-grasso/deprec/model"
 	"github.com/thoas/go-funk"
+	"time"
 )
 
 func Engagement(m model.DataModel, c configuration.Engagement) model.Core {
@@ -14,15 +15,24 @@ func Engagement(m model.DataModel, c configuration.Engagement) model.Core {
 		return *cr
 	}
 
-	totalIssues := len(m.Repository.Issues)
+	issues := m.Repository.SelectIssues(c.IssuePopulation.PullRequests, c.IssuePopulation.Bots)
 
-	totalComments := funk.Sum(funk.Map(m.Repository.Issues, func(i model.Issue) int { return len(i.Contributions) }))
+	totalIssues := len(issues)
+
+	totalComments := funk.Sum(funk.Map(issues, func(i model.Issue) int { return i.Comments }))
 
 	ratio := totalComments / float64(totalIssues)
 
 	ratio *= 100
 
-	cr.IntakeThreshold(ratio, c.IssueCommentsRatioThreshold, c.Weights.IssueCommentsRatio)
+	cr.Measure("Comments per hundred issues", model.GitHubSource).
+		Because("%.0f comments on %d issues", totalComments, totalIssues).
+		IntakeThreshold(ratio, c.IssueCommentsRatioThreshold, c.Weights.IssueCommentsRatio)
+
+	if days, exists := medianResponseDays(issues, func(i model.Issue) *time.Time { return i.FirstMaintainerResponse }); exists {
+		cr.Measure("Median days to maintainer response", model.GitHubSource).
+			IntakeLimit(days, float64(c.MaintainerResponseDaysLimit), c.Weights.MaintainerResponse)
+	}
 
 	return *cr
 }
@@ -0,0 +1,28 @@
+package cores
+
+import (
+	"github.com/a-grasso/deprec/configuration"
+	"github.com/a-grasso/deprec/model"
+)
+
+func KnowledgeBase(m model.DataModel, c configuration.KnowledgeBase) model.Core {
+
+	cr := model.NewCore(model.KnowledgeBase)
+
+	if knowledge := m.Knowledge; knowledge != nil {
+		cr.Measure("Verdict", model.KnowledgeBaseSource).Because("%s", knowledge.DecisionReason()).Intake(verdictStage(knowledge.Verdict), c.Weights.Verdict)
+	}
+
+	return *cr
+}
+
+func verdictStage(verdict model.Verdict) float64 {
+	switch verdict.Recommendation() {
+	case model.NoConcerns:
+		return model.NC
+	case model.Watchlist:
+		return model.W
+	default:
+		return model.DM
+	}
+}
@@ -25,7 +25,7 @@ func Licensing(m model.DataModel, c configuration.Licensing) model.Core {
 		for l, f := range licenses {
 
 			if strings.Contains(license, l) {
-				cr.Intake(f, c.Weights.Repository)
+				cr.Measure("Repository license", model.GitHubSource).Because("license '%s'", license).Intake(f, c.Weights.Repository)
 			}
 		}
 	}
@@ -38,7 +38,7 @@ func Licensing(m model.DataModel, c configuration.Licensing) model.Core {
 				license = strings.ToLower(license)
 				for l, f := range licenses {
 					if strings.Contains(license, l) {
-						cr.Intake(f, c.Weights.Artifact)
+						cr.Measure("Artifact license", model.RegistrySource).Because("license '%s'", license).Intake(f, c.Weights.Artifact)
 					}
 				}
 			}
@@ -49,7 +49,7 @@ func Licensing(m model.DataModel, c configuration.Licensing) model.Core {
 			for _, license := range libraryLicenses {
 				for l, f := range licenses {
 					if strings.Contains(license, l) {
-						cr.Intake(f, c.Weights.Library)
+						cr.Measure("Library license", model.RegistrySource).Because("license '%s'", license).Intake(f, c.Weights.Library)
 					}
 				}
 			}
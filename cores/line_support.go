@@ -0,0 +1,124 @@
+package cores
+
+import (
+	"github.com/a-grasso/deprec/configuration"
+	"github.com/a-grasso/deprec/model"
+	"github.com/a-grasso/deprec/statistics"
+	"github.com/a-grasso/deprec/versioning"
+	"sort"
+	"time"
+)
+
+func LineSupport(m model.DataModel, c configuration.Marking) *model.LineSupport {
+
+	used := versioning.Parse(m.Dependency.Version)
+	if !used.IsValid() {
+		return nil
+	}
+
+	releases := collectLineReleases(m)
+
+	usedLine := used.Line(c.LineDepth)
+
+	latestInLine, lastReleaseInLine, exists := versioning.LastOfLine(releases, usedLine, c.LineDepth)
+	if !exists {
+		return nil
+	}
+
+	result := &model.LineSupport{Line: usedLine, LatestInLine: latestInLine.Original, LastReleaseInLine: lastReleaseInLine}
+
+	var latestLine versioning.Version
+	var newerLineStart time.Time
+	newerLines := make(map[string]bool)
+
+	for _, release := range releases {
+
+		if release.Version.Compare(latestLine) > 0 {
+			latestLine = release.Version
+		}
+
+		line := release.Version.Line(c.LineDepth)
+		if release.Version.Compare(used) <= 0 || line == usedLine {
+			continue
+		}
+
+		newerLines[line] = true
+
+		if newerLineStart.IsZero() || release.Date.Before(newerLineStart) {
+			newerLineStart = release.Date
+		}
+	}
+	result.LatestLine = latestLine.Line(c.LineDepth)
+	result.NewerLines = len(newerLines)
+
+	if result.NewerLines == 0 {
+		return result
+	}
+
+	var backports []time.Time
+	for _, release := range releases {
+		if release.Version.Line(c.LineDepth) == usedLine && release.Date.After(newerLineStart) {
+			backports = append(backports, release.Date)
+		}
+	}
+	result.Backports = len(backports)
+
+	// a line still receiving backports is maintained next to the newer ones, often at a slower pace than the limit allows
+	limit := c.LineLimit
+	if gap := longestGap(newerLineStart, backports); gap > limit {
+		limit = gap
+	}
+
+	monthsSinceLastRelease := statistics.CalculateTimeDifference(result.LastReleaseInLine, statistics.CustomNow())
+	result.EndOfLine = monthsSinceLastRelease > limit
+
+	return result
+}
+
+// longestGap in months between the start of the newer lines and the backports to the used line, 0 without backports
+func longestGap(start time.Time, backports []time.Time) int {
+
+	if len(backports) == 0 {
+		return 0
+	}
+
+	sort.Slice(backports, func(i, j int) bool { return backports[i].Before(backports[j]) })
+
+	longest := 0
+	previous := start
+	for _, backport := range backports {
+		if gap := statistics.CalculateTimeDifference(previous, backport); gap > longest {
+			longest = gap
+		}
+		previous = backport
+	}
+
+	return longest
+}
+
+// releases of the repository and the registry, pre-releases and undated versions are left out
+func collectLineReleases(m model.DataModel) []versioning.Release {
+
+	var result []versioning.Release
+
+	add := func(version versioning.Version, date time.Time) {
+		if !version.IsValid() || version.IsPreRelease() || date.IsZero() {
+			return
+		}
+		result = append(result, versioning.Release{Version: version, Date: date})
+	}
+
+	if m.Repository != nil {
+		for _, release := range m.Repository.Releases {
+			add(versioning.ParseTag(release.Version), release.Date)
+		}
+	}
+
+	if m.Distribution != nil && m.Distribution.Library != nil {
+		for _, release := range m.Distribution.Library.Releases {
+			add(versioning.Parse(release.Version), release.Date)
+		}
+	}
+
+	return result
+}
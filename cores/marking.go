@@ -3,7 +3,8 @@ package cores
 import (
 	"github.com/a-grasso/deprec/configuration"
 	"github.com/a-grasso/deprec/model"
-	"strings"
+	"github.com/a-grasso/deprec/notices"
+	"github.com/a-grasso/deprec/statistics"
 )
 
 func Marking(m model.DataModel, c configuration.Marking) model.Core {
@@ -13,37 +14,91 @@ func Marking(m model.DataModel, c configuration.Marking) model.Core {
 	if m.Repository != nil {
 		archived := m.Repository.Archivation
 		if archived {
-			cr.Intake(model.DM, c.Weights.Archivation)
+			cr.Measure("Archived", model.GitHubSource).Intake(model.DM, c.Weights.Archivation)
 		}
 
-		readme := strings.ToLower(m.Repository.ReadMe)
-		for _, keyword := range c.ReadMeKeywords {
-			if strings.Contains(readme, keyword) {
-				cr.Intake(model.DM, c.Weights.ReadMe)
-			}
+		if m.Repository.MovedTo != "" {
+			cr.Measure("Moved", model.GitHubSource).Because("moved to '%s'", m.Repository.MovedTo).Intake(model.W, c.Weights.Moved)
 		}
+	}
 
-		about := strings.ToLower(m.Repository.About)
-		for _, keyword := range c.AboutKeywords {
-			if strings.Contains(about, keyword) {
-				cr.Intake(model.DM, c.Weights.About)
-			}
-		}
+	found := notices.NewDetector(c).Detect(m)
+
+	intakeNotices(cr, found, model.ReadMeNotice, c.Weights.ReadMe)
+	intakeNotices(cr, found, model.AboutNotice, c.Weights.About)
+	intakeNotices(cr, found, model.DescriptionNotice, c.Weights.Artifact)
+
+	if lifecycle := m.Lifecycle; lifecycle != nil {
+		cr.Measure("Lifecycle", model.EndOfLifeSource).
+			Because("%s cycle %s", lifecycle.Product, lifecycle.Cycle).
+			Intake(lifecycleStage(lifecycle, c), c.Weights.EndOfLife)
+	}
+
+	if support := m.LineSupport; support != nil && support.EndOfLine {
+		cr.Measure("End of line", model.RegistrySource).
+			Because("no release of line %s since %s", support.Line, support.LastReleaseInLine.Format("2006-01-02")).
+			Intake(model.DM, c.Weights.EndOfLine)
 	}
 
 	if distribution := m.Distribution; distribution != nil {
 
 		if artifact := distribution.Artifact; artifact != nil {
 
-			description := strings.ToLower(artifact.Description)
-
-			for _, keyword := range c.ArtifactDescriptionKeywords {
-				if strings.Contains(description, keyword) {
-					cr.Intake(model.DM, c.Weights.ReadMe)
-				}
+			if artifact.Relocation != nil {
+				cr.Measure("Relocation", model.MavenCentralSource).Because("relocated to %s", artifact.Relocation.Coordinates()).Intake(model.DM, c.Weights.Relocation)
 			}
 		}
 	}
 
 	return *cr
 }
+
+var noticeSources = map[model.NoticeSource]string{
+	model.ReadMeNotice:      model.GitHubSource,
+	model.AboutNotice:       model.GitHubSource,
+	model.DescriptionNotice: model.RegistrySource,
+}
+
+// intakeNotices counts a source once, a prominent notice asks for a decision while one buried in the text is worth watching
+func intakeNotices(cr *model.Core, found []model.DeprecationNotice, source model.NoticeSource, weight float64) {
+
+	stage := -1.0
+	var evidence model.DeprecationNotice
+
+	for _, notice := range found {
+		if notice.Source != source {
+			continue
+		}
+		if notice.Prominent() {
+			stage, evidence = model.DM, notice
+			break
+		}
+		if stage < 0 {
+			stage, evidence = model.W, notice
+		}
+	}
+
+	if stage >= 0 {
+		cr.Measure("Deprecation notice in "+string(source), noticeSources[source]).
+			Because("%s: %s", evidence.Location, evidence.Snippet).
+			Intake(stage, weight)
+	}
+}
+
+// lifecycleStage maps a published support schedule onto the recommendation buckets
+func lifecycleStage(lifecycle *model.Lifecycle, c configuration.Marking) float64 {
+
+	if lifecycle.EndOfLife {
+		return model.DM
+	}
+
+	if eol := lifecycle.EndOfLifeDate; eol != nil && statistics.CalculateTimeDifference(statistics.CustomNow(), *eol) <= c.EndOfLifeNotice {
+		return model.W
+	}
+
+	if lifecycle.EndOfSupport {
+		return model.NIA
+	}
+
+	return model.NC
+}
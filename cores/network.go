@@ -25,7 +25,8 @@ func Network(m model.DataModel, c configuration.Network) model.Core {
 			repositoryNetwork += org.Followers
 		}
 
-		cr.IntakeThreshold(float64(repositoryNetwork), float64(c.Threshold), c.Weights.RepositoryNetwork)
+		cr.Measure("Repository network", model.GitHubSource).
+			IntakeThreshold(float64(repositoryNetwork), float64(c.Threshold), c.Weights.RepositoryNetwork)
 	}
 
 	return *cr
@@ -32,7 +32,9 @@ func Participation(m model.DataModel, c configuration.Participation) model.Core
 
 	ratio := float64(len(users)) / float64(totalUsers) * 100
 
-	cr.IntakeThreshold(ratio, float64(c.ThirdPartyCommitThreshold), c.Weights.ThirdPartyCommits)
+	cr.Measure("Third party contributors (%)", model.GitHubSource).
+		Because("%d of %d contributors", len(users), totalUsers).
+		IntakeThreshold(ratio, float64(c.ThirdPartyCommitThreshold), c.Weights.ThirdPartyCommits)
 
 	return *cr
 }
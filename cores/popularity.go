@@ -15,7 +15,9 @@ func Popularity(m model.DataModel, c configuration.Popularity) model.Core {
 		repositoryPopularity += m.Repository.Watchers
 		repositoryPopularity += m.Repository.Forks
 
-		cr.IntakeThreshold(float64(repositoryPopularity), float64(c.Threshold), c.Weights.RepositoryPopularity)
+		cr.Measure("Repository popularity", model.GitHubSource).
+			Because("%d stars, %d watchers, %d forks", m.Repository.Stars, m.Repository.Watchers, m.Repository.Forks).
+			IntakeThreshold(float64(repositoryPopularity), float64(c.Threshold), c.Weights.RepositoryPopularity)
 	}
 
 	return *cr
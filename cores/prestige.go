@@ -65,7 +65,7 @@ func Prestige(m model.DataModel, c configuration.Prestige) model.Core {
 
 	//TODO: too much for intake
 	result := funk.Sum(prestiges) / float64(len(prestiges))
-	cr.Intake(result, c.Weights.Contributors)
+	cr.Measure("Contributor prestige", model.GitHubSource).Intake(result, c.Weights.Contributors)
 
 	return *cr
 }
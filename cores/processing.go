@@ -7,6 +7,7 @@ import (
 	"github.com/thoas/go-funk"
 	"math"
 	"sort"
+	"time"
 )
 
 func Processing(m model.DataModel, c configuration.Processing) model.Core {
@@ -17,7 +18,7 @@ func Processing(m model.DataModel, c configuration.Processing) model.Core {
 		return *cr
 	}
 
-	issues := m.Repository.Issues
+	issues := m.Repository.SelectIssues(c.IssuePopulation.PullRequests, c.IssuePopulation.Bots)
 
 	if len(issues) == 0 {
 		return *cr
@@ -30,14 +31,38 @@ func Processing(m model.DataModel, c configuration.Processing) model.Core {
 	closedIssues := funk.Filter(issues, func(i model.Issue) bool { return i.State == model.IssueStateClosed }).([]model.Issue)
 
 	closingTime := averageClosingTime(closedIssues)
-	cr.IntakeLimit(closingTime, float64(c.ClosingTimeLimit), c.Weights.AverageClosingTime)
+	cr.Measure("Average closing time in months", model.GitHubSource).
+		Because("%d of %d issues closed", len(closedIssues), len(issues)).
+		IntakeLimit(closingTime, float64(c.ClosingTimeLimit), c.Weights.AverageClosingTime)
 
 	burn := averageBurn(issues, closedIssues, c.BurnPercentile)
-	cr.Intake(burn, c.Weights.Burn)
+	cr.Measure("Issue burn", model.GitHubSource).Intake(burn, c.Weights.Burn)
+
+	if days, exists := medianResponseDays(issues, func(i model.Issue) *time.Time { return i.FirstResponse }); exists {
+		cr.Measure("Median days to first response", model.GitHubSource).
+			IntakeLimit(days, float64(c.FirstResponseDaysLimit), c.Weights.FirstResponse)
+	}
 
 	return *cr
 }
 
+// medianResponseDays only considers issues that got a response, so it says nothing about ignored issues
+func medianResponseDays(issues []model.Issue, response func(i model.Issue) *time.Time) (float64, bool) {
+
+	var days []float64
+	for _, issue := range issues {
+		if r := response(issue); r != nil {
+			days = append(days, r.Sub(issue.CreationTime).Hours()/24)
+		}
+	}
+
+	if len(days) == 0 {
+		return 0, false
+	}
+
+	return statistics.Median(days), true
+}
+
 func averageBurn(issues []model.Issue, closedIssues []model.Issue, percentile float64) float64 {
 
 	sortedKeysOpen, opened := statistics.GroupBy(issues, func(i model.Issue) statistics.Key {
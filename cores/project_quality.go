@@ -10,26 +10,80 @@ func ProjectQuality(m model.DataModel, c configuration.ProjectQuality) model.Cor
 
 	if m.Repository != nil {
 
+		readme := cr.Measure("ReadMe", model.GitHubSource)
 		if m.Repository.ReadMe != "" {
-			cr.Intake(model.NC, c.Weights.ReadMe)
+			readme.Intake(model.NC, c.Weights.ReadMe)
 		} else {
-			cr.Intake(model.DM, c.Weights.ReadMe)
+			readme.Because("no readme").Intake(model.DM, c.Weights.ReadMe)
 		}
 
+		license := cr.Measure("License", model.GitHubSource)
 		if m.Repository.License != "" {
-			cr.Intake(model.NC, c.Weights.License)
+			license.Because("license '%s'", m.Repository.License).Intake(model.NC, c.Weights.License)
 		} else {
-			cr.Intake(model.DM, c.Weights.License)
+			license.Because("no license").Intake(model.DM, c.Weights.License)
 		}
 
 		if m.Repository.About != "" {
-			cr.Intake(model.NIA, c.Weights.About)
+			cr.Measure("About", model.GitHubSource).Intake(model.NIA, c.Weights.About)
 		}
 
 		if m.Repository.AllowForking {
-			cr.Intake(model.NIA, c.Weights.AllowForking)
+			cr.Measure("Forking allowed", model.GitHubSource).Intake(model.NIA, c.Weights.AllowForking)
 		}
+
+		if repository := m.Repository.RepositoryData; repository != nil {
+			cr.Measure("Security posture", model.GitHubSource).
+				Because("security policy %t", repository.SecurityPolicy).
+				Intake(securityPosture(*repository), c.Weights.SecurityPosture)
+		}
+	}
+
+	if scorecard := m.Scorecard; scorecard != nil {
+		intakeCheck(cr, scorecard, model.ScorecardCodeReview, c.Weights.CodeReview)
+		intakeCheck(cr, scorecard, model.ScorecardCITests, c.Weights.CITests)
+		intakeCheck(cr, scorecard, model.ScorecardSecurityPolicy, c.Weights.SecurityPolicy)
+		intakeCheck(cr, scorecard, model.ScorecardBranchProtection, c.Weights.BranchProtection)
+	}
+
+	return *cr
+}
+
+// Maintained reflects the scorecard check of the same name, which looks at commit and issue activity of the last 90 days
+func Maintained(m model.DataModel, c configuration.Maintained) model.Core {
+	cr := model.NewCore(model.Maintained)
+
+	if scorecard := m.Scorecard; scorecard != nil {
+		intakeCheck(cr, scorecard, model.ScorecardMaintained, c.Weights.Scorecard)
 	}
 
 	return *cr
 }
+
+func intakeCheck(cr *model.Core, scorecard *model.Scorecard, check string, weight float64) {
+	if score, exists := scorecard.Check(check); exists {
+		cr.Measure("Scorecard "+check, model.ScorecardSource).IntakeThreshold(float64(score), 10, weight)
+	}
+}
+
+// securityPosture is the share of enabled security features among those visible, the policy is always visible
+func securityPosture(repository model.RepositoryData) float64 {
+
+	known, enabled := 1, 0
+
+	if repository.SecurityPolicy {
+		enabled++
+	}
+
+	for _, feature := range []*bool{repository.PrivateVulnerabilityReporting, repository.VulnerabilityAlerts} {
+		if feature == nil {
+			continue
+		}
+		known++
+		if *feature {
+			enabled++
+		}
+	}
+
+	return float64(enabled) / float64(known)
+}
@@ -0,0 +1,28 @@
+package cores
+
+import (
+	"github.com/a-grasso/deprec/model"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSecurityPosture(t *testing.T) {
+
+	enabled, disabled := true, false
+
+	tests := []struct {
+		name       string
+		repository model.RepositoryData
+		posture    float64
+	}{
+		{"nothing visible", model.RepositoryData{}, 0},
+		{"policy only", model.RepositoryData{SecurityPolicy: true}, 1},
+		{"all enabled", model.RepositoryData{SecurityPolicy: true, PrivateVulnerabilityReporting: &enabled, VulnerabilityAlerts: &enabled}, 1},
+		{"alerts disabled", model.RepositoryData{SecurityPolicy: true, VulnerabilityAlerts: &disabled}, 0.5},
+		{"no policy", model.RepositoryData{PrivateVulnerabilityReporting: &enabled, VulnerabilityAlerts: &disabled}, 1.0 / 3},
+	}
+
+	for _, test := range tests {
+		assert.InDelta(t, test.posture, securityPosture(test.repository), 0.0001, test.name)
+	}
+}
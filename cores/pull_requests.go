@@ -0,0 +1,79 @@
+package cores
+
+import (
+	"github.com/a-grasso/deprec/configuration"
+	"github.com/a-grasso/deprec/model"
+	"github.com/a-grasso/deprec/statistics"
+	"github.com/thoas/go-funk"
+	"time"
+)
+
+func PullRequests(m model.DataModel, c configuration.PullRequests) model.Core {
+
+	cr := model.NewCore(model.PullRequests)
+
+	if m.Repository == nil || len(m.Repository.PullRequests) == 0 {
+		return *cr
+	}
+
+	pullRequests := m.Repository.SelectPullRequests(!c.ExcludeBots)
+
+	merged := funk.Filter(pullRequests, func(pr model.PullRequest) bool { return pr.State == model.PullRequestMerged }).([]model.PullRequest)
+	closed := funk.Filter(pullRequests, func(pr model.PullRequest) bool { return pr.State == model.PullRequestClosed }).([]model.PullRequest)
+	open := funk.Filter(pullRequests, func(pr model.PullRequest) bool { return pr.State == model.PullRequestOpen }).([]model.PullRequest)
+
+	if decided := len(merged) + len(closed); decided != 0 {
+		cr.Measure("Merge rate", model.GitHubSource).
+			Because("%d of %d decided pull requests merged", len(merged), decided).
+			Intake(float64(len(merged))/float64(decided), c.Weights.MergeRate)
+	}
+
+	if len(merged) != 0 {
+		days := funk.Map(merged, func(pr model.PullRequest) float64 { return pr.MergeTime.Sub(pr.CreationTime).Hours() / 24 }).([]float64)
+		cr.Measure("Median days to merge", model.GitHubSource).IntakeLimit(statistics.Median(days), float64(c.MergeTimeDaysLimit), c.Weights.MergeTime)
+	}
+
+	now := statistics.CustomNow()
+	minimumAge := time.Duration(c.IgnoredMinimumDays) * 24 * time.Hour
+
+	// open pull requests younger than the minimum age cannot be told apart from ignored ones yet
+	external := funk.Filter(pullRequests, func(pr model.PullRequest) bool {
+		return pr.IsExternal() && (pr.State != model.PullRequestOpen || now.Sub(pr.CreationTime) >= minimumAge)
+	}).([]model.PullRequest)
+	if len(external) != 0 {
+		ignored := funk.Filter(external, isIgnored).([]model.PullRequest)
+		cr.Measure("Handled external pull requests", model.GitHubSource).
+			Because("%d of %d external pull requests ignored", len(ignored), len(external)).
+			Intake(1-float64(len(ignored))/float64(len(external)), c.Weights.IgnoredExternal)
+	}
+
+	if oldest := oldestOpen(m.Repository.OldestOpenPullRequest, open, !c.ExcludeBots); oldest != nil {
+		months := statistics.CalculateTimeDifference(oldest.CreationTime, now)
+		cr.Measure("Months oldest pull request is open", model.GitHubSource).
+			Because("#%d opened on %s", oldest.Number, oldest.CreationTime.Format("2006-01-02")).
+			IntakeLimit(float64(months), float64(c.OldestOpenLimit), c.Weights.OldestOpen)
+	}
+
+	return *cr
+}
+
+// a pull request is ignored when it was never merged and nobody reviewed or commented on it, whether it is still open or was closed silently
+func isIgnored(pr model.PullRequest) bool {
+	return pr.State != model.PullRequestMerged && pr.Reviews == 0 && pr.Comments == 0
+}
+
+// oldestOpen prefers the separately looked up oldest open pull request, the recent ones only serve as fallback
+func oldestOpen(lookedUp *model.PullRequest, open []model.PullRequest, bots bool) *model.PullRequest {
+
+	if lookedUp != nil && (bots || !lookedUp.Bot) {
+		return lookedUp
+	}
+
+	var oldest *model.PullRequest
+	for i, pr := range open {
+		if oldest == nil || pr.CreationTime.Before(oldest.CreationTime) {
+			oldest = &open[i]
+		}
+	}
+	return oldest
+}
@@ -0,0 +1,140 @@
+package cores
+
+import (
+	"github.com/a-grasso/deprec/configuration"
+	"github.com/a-grasso/deprec/model"
+	"github.com/a-grasso/deprec/statistics"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func pullRequestsConfig(excludeBots bool) configuration.PullRequests {
+
+	var c configuration.PullRequests
+	c.MergeTimeDaysLimit = 30
+	c.OldestOpenLimit = 24
+	c.IgnoredMinimumDays = 14
+	c.ExcludeBots = excludeBots
+	c.Weights.MergeRate = 1
+	c.Weights.MergeTime = 1
+	c.Weights.IgnoredExternal = 1
+	c.Weights.OldestOpen = 1
+
+	return c
+}
+
+func daysAgo(days int) time.Time {
+	return statistics.CustomNow().AddDate(0, 0, -days)
+}
+
+func merged(number, age, days int) model.PullRequest {
+	mergeTime := daysAgo(age - days)
+	return model.PullRequest{Number: number, State: model.PullRequestMerged, AuthorAssociation: "MEMBER", CreationTime: daysAgo(age), MergeTime: &mergeTime}
+}
+
+func statement(core model.Core, metric string) *model.Statement {
+	for _, s := range core.Statements {
+		if s.Metric == metric {
+			return &s
+		}
+	}
+	return nil
+}
+
+func TestPullRequestsMergeRateAndTime(t *testing.T) {
+
+	repository := &model.Repository{PullRequests: []model.PullRequest{
+		merged(1, 100, 2),
+		merged(2, 100, 4),
+		merged(3, 100, 10),
+		{Number: 4, State: model.PullRequestClosed, AuthorAssociation: "MEMBER", CreationTime: daysAgo(50), Comments: 1},
+		{Number: 5, State: model.PullRequestOpen, AuthorAssociation: "MEMBER", CreationTime: daysAgo(1)},
+	}}
+
+	core := PullRequests(model.DataModel{Repository: repository}, pullRequestsConfig(false))
+
+	rate := statement(core, "Merge rate")
+	assert.NotNil(t, rate)
+	assert.Equal(t, 0.75, rate.Value)
+	assert.Equal(t, "3 of 4 decided pull requests merged", rate.Evidence)
+
+	mergeTime := statement(core, "Median days to merge")
+	assert.NotNil(t, mergeTime)
+	assert.InDelta(t, 4, mergeTime.Value, 0.0001)
+
+	// nobody from outside opened a pull request
+	assert.Nil(t, statement(core, "Handled external pull requests"))
+}
+
+func TestPullRequestsIgnoredExternal(t *testing.T) {
+
+	repository := &model.Repository{PullRequests: []model.PullRequest{
+		{Number: 1, State: model.PullRequestClosed, AuthorAssociation: "NONE", CreationTime: daysAgo(60)},
+		{Number: 2, State: model.PullRequestOpen, AuthorAssociation: "CONTRIBUTOR", CreationTime: daysAgo(30)},
+		{Number: 3, State: model.PullRequestOpen, AuthorAssociation: "NONE", CreationTime: daysAgo(30), Reviews: 1},
+		{Number: 4, State: model.PullRequestClosed, AuthorAssociation: "FIRST_TIME_CONTRIBUTOR", CreationTime: daysAgo(60), Comments: 2},
+		// too young to tell whether it is ignored
+		{Number: 5, State: model.PullRequestOpen, AuthorAssociation: "NONE", CreationTime: daysAgo(3)},
+		// maintainers do not count as external
+		{Number: 6, State: model.PullRequestClosed, AuthorAssociation: "OWNER", CreationTime: daysAgo(60)},
+	}}
+
+	core := PullRequests(model.DataModel{Repository: repository}, pullRequestsConfig(false))
+
+	handled := statement(core, "Handled external pull requests")
+	assert.NotNil(t, handled)
+	assert.Equal(t, "2 of 4 external pull requests ignored", handled.Evidence)
+	assert.Equal(t, 0.5, handled.Value)
+}
+
+func TestPullRequestsOldestOpen(t *testing.T) {
+
+	recent := []model.PullRequest{
+		{Number: 8, State: model.PullRequestOpen, AuthorAssociation: "MEMBER", CreationTime: daysAgo(40)},
+		{Number: 9, State: model.PullRequestOpen, AuthorAssociation: "MEMBER", CreationTime: daysAgo(10)},
+	}
+
+	bot := &model.PullRequest{Number: 1, State: model.PullRequestOpen, CreationTime: daysAgo(400), Bot: true}
+	human := &model.PullRequest{Number: 2, State: model.PullRequestOpen, CreationTime: daysAgo(400)}
+
+	tests := []struct {
+		name        string
+		lookedUp    *model.PullRequest
+		excludeBots bool
+		number      string
+	}{
+		{"fallback to the recent ones", nil, false, "#8 "},
+		{"looked up", human, true, "#2 "},
+		{"looked up bot included", bot, false, "#1 "},
+		{"looked up bot excluded", bot, true, "#8 "},
+	}
+
+	for _, test := range tests {
+		repository := &model.Repository{PullRequests: recent, OldestOpenPullRequest: test.lookedUp}
+
+		oldest := statement(PullRequests(model.DataModel{Repository: repository}, pullRequestsConfig(test.excludeBots)), "Months oldest pull request is open")
+
+		assert.NotNil(t, oldest, test.name)
+		assert.Contains(t, oldest.Evidence, test.number, test.name)
+	}
+}
+
+func TestPullRequestsExcludeBots(t *testing.T) {
+
+	dependencyUpdate := merged(2, 100, 1)
+	dependencyUpdate.Bot = true
+
+	closedUpdate := model.PullRequest{Number: 3, State: model.PullRequestClosed, CreationTime: daysAgo(50), Bot: true}
+
+	repository := &model.Repository{PullRequests: []model.PullRequest{merged(1, 100, 6), dependencyUpdate, closedUpdate}}
+
+	withBots := PullRequests(model.DataModel{Repository: repository}, pullRequestsConfig(false))
+	assert.Equal(t, "2 of 3 decided pull requests merged", statement(withBots, "Merge rate").Evidence)
+	assert.Equal(t, "1 of 1 external pull requests ignored", statement(withBots, "Handled external pull requests").Evidence)
+
+	withoutBots := PullRequests(model.DataModel{Repository: repository}, pullRequestsConfig(true))
+	assert.Equal(t, "1 of 1 decided pull requests merged", statement(withoutBots, "Merge rate").Evidence)
+	assert.InDelta(t, 6, statement(withoutBots, "Median days to merge").Value, 0.0001)
+	assert.Nil(t, statement(withoutBots, "Handled external pull requests"))
+}
@@ -12,18 +12,20 @@ func Recentness(m model.DataModel, c configuration.Recentness) model.Core {
 
 	cr := model.NewCore(model.Recentness)
 
-	repositoryPart(cr, c, m.Repository)
+	repositoryPart(cr, c, m.Repository, !c.ExcludeBots)
+
+	distributionPart(cr, c, m.Distribution, m.Repository)
 
 	return *cr
 }
 
-func repositoryPart(cr *model.Core, c configuration.Recentness, repository *model.Repository) {
+func repositoryPart(cr *model.Core, c configuration.Recentness, repository *model.Repository, bots bool) {
 	if repository == nil {
 		return
 	}
 
-	commits := repository.Commits
-	if commits != nil {
+	commits := repository.SelectCommits(bots)
+	if len(commits) != 0 {
 		sort.Slice(commits, func(i, j int) bool {
 			return commits[i].Timestamp.Before(commits[j].Timestamp)
 		})
@@ -33,9 +35,12 @@ func repositoryPart(cr *model.Core, c configuration.Recentness, repository *mode
 
 		averageMonthsSinceLastCommits := averageMonthsSinceLast(commits, c.TimeframePercentileCommits)
 
-		cr.IntakeLimit(float64(monthsSinceLastCommit), float64(c.CommitLimit), c.Weights.MonthsSinceLastCommit)
+		cr.Measure("Months since last commit", model.GitHubSource).
+			Because("last commit on %s", lastCommit.Timestamp.Format("2006-01-02")).
+			IntakeLimit(float64(monthsSinceLastCommit), float64(c.CommitLimit), c.Weights.MonthsSinceLastCommit)
 
-		cr.IntakeLimit(averageMonthsSinceLastCommits, float64(c.CommitLimit), c.Weights.AverageMonthsSinceLastCommits)
+		cr.Measure("Average months since last commits", model.GitHubSource).
+			IntakeLimit(averageMonthsSinceLastCommits, float64(c.CommitLimit), c.Weights.AverageMonthsSinceLastCommits)
 	}
 
 	releases := repository.Releases
@@ -47,7 +52,32 @@ func repositoryPart(cr *model.Core, c configuration.Recentness, repository *mode
 		lastRelease := releases[len(releases)-1]
 		monthsSinceLastRelease := statistics.CalculateTimeDifference(lastRelease.Date, statistics.CustomNow())
 
-		cr.IntakeLimit(float64(monthsSinceLastRelease), float64(c.ReleaseLimit), c.Weights.MonthsSinceLastRelease)
+		cr.Measure("Months since last release", model.GitHubSource).
+			Because("release '%s' on %s", lastRelease.Version, lastRelease.Date.Format("2006-01-02")).
+			IntakeLimit(float64(monthsSinceLastRelease), float64(c.ReleaseLimit), c.Weights.MonthsSinceLastRelease)
+	}
+}
+
+func distributionPart(cr *model.Core, c configuration.Recentness, distribution *model.Distribution, repository *model.Repository) {
+	if distribution == nil || distribution.Library == nil {
+		return
+	}
+
+	cadence := statistics.AnalyzeCadence(distribution.Library.Releases, c.ReleaseLimit)
+	if cadence == nil {
+		return
+	}
+
+	// repository releases take precedence, publications only fill in where there are none
+	if repository == nil || len(repository.Releases) == 0 {
+		cr.Measure("Months since last publication", model.RegistrySource).
+			IntakeLimit(cadence.CurrentGap, float64(c.ReleaseLimit), c.Weights.MonthsSinceLastPublication)
+	}
+
+	if cadence.MedianGap != 0 {
+		cr.Measure("Current over median publication gap", model.RegistrySource).
+			Because("current gap %.1f months, median gap %.1f months", cadence.CurrentGap, cadence.MedianGap).
+			IntakeLimit(cadence.CurrentOverMedian(), c.CadenceTolerance, c.Weights.ReleaseCadence)
 	}
 }
 
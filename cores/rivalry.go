@@ -3,11 +3,18 @@ package cores
 import (
 	"github.com/a-grasso/deprec/configuration"
 	"github.com/a-grasso/deprec/model"
+	"github.com/a-grasso/deprec/statistics"
+	"github.com/a-grasso/deprec/versioning"
+	"time"
 )
 
 func Rivalry(m model.DataModel, c configuration.Rivalry) model.Core {
 	cr := model.NewCore(model.Rivalry)
 
+	if m.Repository != nil && m.Repository.Fork {
+		cr.Measure("Fork", model.GitHubSource).Because("fork of '%s'", m.Repository.ForkOf).Intake(model.W, c.Weights.Fork)
+	}
+
 	if m.Distribution == nil {
 		return *cr
 	}
@@ -16,7 +23,11 @@ func Rivalry(m model.DataModel, c configuration.Rivalry) model.Core {
 		return *cr
 	}
 
-	cr.Intake(artifactIsLatest(m), c.Weights.IsLatest)
+	cr.Measure("Used version is latest", model.RegistrySource).
+		Because("using '%s', latest is '%s'", m.Distribution.Artifact.Version, m.Distribution.Library.LatestVersion).
+		Intake(artifactIsLatest(m), c.Weights.IsLatest)
+
+	versionLag(cr, c, m.Distribution.Artifact, m.Distribution.Library)
 
 	return *cr
 }
@@ -33,3 +44,82 @@ func artifactIsLatest(m model.DataModel) float64 {
 		return 0
 	}
 }
+
+func versionLag(cr *model.Core, c configuration.Rivalry, artifact *model.Artifact, library *model.Library) {
+
+	used := artifact.Version
+	latest := latestVersion(library)
+
+	if !versioning.Parse(used).IsValid() || latest == "" {
+		return
+	}
+
+	distance := versioning.DistanceBetween(used, latest)
+
+	cr.Measure("Majors behind", model.RegistrySource).
+		Because("using '%s', latest is '%s'", used, latest).
+		IntakeLimit(float64(distance.Majors), float64(c.MajorsBehindLimit), c.Weights.MajorsBehind)
+
+	if distance.Majors == 0 {
+		cr.Measure("Minors behind", model.RegistrySource).
+			Because("using '%s', latest is '%s'", used, latest).
+			IntakeLimit(float64(distance.Minors), float64(c.MinorsBehindLimit), c.Weights.MinorsBehind)
+	}
+
+	dates := releaseDates(library.Releases)
+
+	usedDate, usedKnown := dates[used]
+	if !usedKnown && !artifact.Date.IsZero() {
+		usedDate, usedKnown = artifact.Date, true
+	}
+	latestDate, latestKnown := dates[latest]
+
+	if usedKnown && latestKnown {
+		cr.Measure("Libyear", model.RegistrySource).
+			Because("'%s' released %s, '%s' released %s", used, usedDate.Format("2006-01-02"), latest, latestDate.Format("2006-01-02")).
+			IntakeLimit(libyear(usedDate, latestDate), c.LibyearLimit, c.Weights.Libyear)
+	}
+
+	if _, lastInMajor, found := versioning.LastOfLine(parseReleases(library.Releases), versioning.Parse(used).Line(1), 1); found && !lastInMajor.IsZero() {
+		monthsSince := statistics.CalculateTimeDifference(lastInMajor, statistics.CustomNow())
+		cr.Measure("Months since last release of used major", model.RegistrySource).
+			Because("last release on %s", lastInMajor.Format("2006-01-02")).
+			IntakeLimit(float64(monthsSince), float64(c.MajorLineLimit), c.Weights.MajorLine)
+	}
+}
+
+func latestVersion(library *model.Library) string {
+	if library.LatestRelease != "" {
+		return library.LatestRelease
+	}
+	if library.LatestVersion != "" {
+		return library.LatestVersion
+	}
+	return versioning.Latest(library.Versions)
+}
+
+func releaseDates(releases []model.Release) map[string]time.Time {
+	dates := make(map[string]time.Time)
+	for _, release := range releases {
+		if !release.Date.IsZero() {
+			dates[release.Version] = release.Date
+		}
+	}
+	return dates
+}
+
+// libyear is the age difference between the used and the latest release in years
+func libyear(used, latest time.Time) float64 {
+	if latest.Before(used) {
+		return 0
+	}
+	return latest.Sub(used).Hours() / 24 / 365.25
+}
+
+func parseReleases(releases []model.Release) []versioning.Release {
+	var result []versioning.Release
+	for _, release := range releases {
+		result = append(result, versioning.Release{Version: versioning.Parse(release.Version), Date: release.Date})
+	}
+	return result
+}
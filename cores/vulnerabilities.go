@@ -3,6 +3,7 @@ package cores
 import (
 	"github.com/a-grasso/deprec/configuration"
 	"github.com/a-grasso/deprec/model"
+	"strings"
 )
 
 func Vulnerabilities(m model.DataModel, c configuration.Vulnerabilities) model.Core {
@@ -16,8 +17,62 @@ func Vulnerabilities(m model.DataModel, c configuration.Vulnerabilities) model.C
 	vulnerabilities := m.VulnerabilityIndex.TotalVulnerabilitiesCount
 
 	if vulnerabilities > 0 {
-		cr.Intake(model.DM, c.Weights.CVE)
+		cr.Measure("Known vulnerabilities", model.OSSIndexSource).Because("%d vulnerabilities", vulnerabilities).Intake(model.DM, c.Weights.CVE)
 	}
 
+	if len(m.VulnerabilityIndex.Advisories) == 0 {
+		return *cr
+	}
+
+	affecting := m.VulnerabilityIndex.AffectingAdvisories()
+
+	// a package with a history of advisories, none of which reach the used version, has been patched in time
+	if len(affecting) == 0 {
+		cr.Measure("Security advisories", model.AdvisorySource).
+			Because("none of %d advisories affect the used version", len(m.VulnerabilityIndex.Advisories)).
+			Intake(model.NC, c.Weights.Advisory)
+	}
+
+	// advisories with a cve oss index reports are already counted as known vulnerabilities
+	unreported := m.VulnerabilityIndex.UnreportedAdvisories()
+	if len(unreported) == 0 {
+		return *cr
+	}
+
+	worst := unreported[0]
+	for _, advisory := range unreported[1:] {
+		if severityRank(advisory.Severity) > severityRank(worst.Severity) {
+			worst = advisory
+		}
+	}
+
+	cr.Measure("Security advisories", model.AdvisorySource).
+		Because("%d advisories affect the used version, worst is %s (%s) affecting %s", len(unreported), worst.ID, worst.Severity, worst.VulnerableVersionRange).
+		Intake(severityStage(worst.Severity), c.Weights.Advisory)
+
 	return *cr
 }
+
+func severityRank(severity string) int {
+	switch strings.ToUpper(severity) {
+	case "CRITICAL":
+		return 3
+	case "HIGH":
+		return 2
+	case "MODERATE":
+		return 1
+	default:
+		return 0
+	}
+}
+
+func severityStage(severity string) float64 {
+	switch strings.ToUpper(severity) {
+	case "CRITICAL", "HIGH":
+		return model.DM
+	case "MODERATE":
+		return model.W
+	default:
+		return model.NIA
+	}
+}
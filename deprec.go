@@ -7,8 +7,11 @@ import (
 	"github.com/a-grasso/deprec/agent"
 	"github.com/a-grasso/deprec/cache"
 	"github.com/a-grasso/deprec/configuration"
+	"github.com/a-grasso/deprec/explanation"
+	"github.com/a-grasso/deprec/knowledgebase"
 	"github.com/a-grasso/deprec/logging"
 	"github.com/a-grasso/deprec/model"
+	"sort"
 	"sync"
 )
 
@@ -16,6 +19,23 @@ type Result struct {
 	Results map[string]agent.Result
 }
 
+// Explanations explains the result of every dependency, ordered by dependency name
+func (r *Result) Explanations() []explanation.Explanation {
+
+	names := make([]string, 0, len(r.Results))
+	for name := range r.Results {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var result []explanation.Explanation
+	for _, name := range names {
+		result = append(result, explanation.Explain(r.Results[name]))
+	}
+
+	return result
+}
+
 type Client struct {
 	Configuration configuration.Configuration
 }
@@ -44,16 +64,34 @@ func (c *Client) Run(sbom *cyclonedx.BOM, runConfig RunConfig) *Result {
 
 	dependencies := parseSBOM(sbom)
 
+	kb := loadKnowledgeBase(c.Configuration.Extraction.KnowledgeBase)
+
 	var agentResults []agent.Result
 	if runConfig.Mode == Linear {
-		agentResults = linear(c.Configuration, dependencies)
+		agentResults = linear(c.Configuration, kb, dependencies)
 	} else if runConfig.Mode == Parallel {
-		agentResults = parallel(dependencies, runConfig.NumWorkers, c.Configuration)
+		agentResults = parallel(dependencies, runConfig.NumWorkers, c.Configuration, kb)
 	}
 
 	return convertAgentResults(agentResults)
 }
 
+// loadKnowledgeBase once for all agents of a run, the run goes on without it if it cannot be loaded
+func loadKnowledgeBase(config configuration.LocalKnowledgeBase) *knowledgebase.KnowledgeBase {
+
+	if config.Directory == "" {
+		return nil
+	}
+
+	kb, err := knowledgebase.Load(config.Directory)
+	if err != nil {
+		logging.SugaredLogger.Warnf("could not load knowledge base : %s", err)
+		return nil
+	}
+
+	return kb
+}
+
 func convertAgentResults(agentResults []agent.Result) *Result {
 
 	resultMap := make(map[string]agent.Result, 0)
@@ -65,7 +103,7 @@ func convertAgentResults(agentResults []agent.Result) *Result {
 	return &Result{Results: resultMap}
 }
 
-func linear(config configuration.Configuration, dependencies []model.Dependency) []agent.Result {
+func linear(config configuration.Configuration, kb *knowledgebase.KnowledgeBase, dependencies []model.Dependency) []agent.Result {
 	var agentResults []agent.Result
 	totalDependencies := len(dependencies)
 
@@ -83,6 +121,7 @@ func linear(config configuration.Configuration, dependencies []model.Dependency)
 		logging.SugaredLogger.Infof("running agent for dependency '%s:%s' %d/%d", dep.Name, dep.Version, i, totalDependencies)
 
 		a := agent.NewAgent(dep, config)
+		a.KnowledgeBase = kb
 		agentResult := a.Run(cache)
 		agentResults = append(agentResults, agentResult)
 	}
@@ -90,7 +129,7 @@ func linear(config configuration.Configuration, dependencies []model.Dependency)
 	return agentResults
 }
 
-func parallel(deps []model.Dependency, numWorkers int, config configuration.Configuration) []agent.Result {
+func parallel(deps []model.Dependency, numWorkers int, config configuration.Configuration, kb *knowledgebase.KnowledgeBase) []agent.Result {
 	agentResults := make(chan agent.Result, len(deps))
 	dependencies := make(chan model.Dependency, len(deps))
 
@@ -108,7 +147,7 @@ func parallel(deps []model.Dependency, numWorkers int, config configuration.Conf
 
 		go func() {
 			defer wg.Done()
-			worker(config, cache, dependencies, agentResults, w)
+			worker(config, kb, cache, dependencies, agentResults, w)
 		}()
 	}
 
@@ -134,12 +173,13 @@ func parallel(deps []model.Dependency, numWorkers int, config configuration.Conf
 	return result
 }
 
-func worker(configuration configuration.Configuration, cache *cache.Cache, dependencies <-chan model.Dependency, results chan<- agent.Result, worker int) {
+func worker(configuration configuration.Configuration, kb *knowledgebase.KnowledgeBase, cache *cache.Cache, dependencies <-chan model.Dependency, results chan<- agent.Result, worker int) {
 
 	for dep := range dependencies {
 		logging.SugaredLogger.Infof("worker %d running agent for dependency '%s:%s' %d/%d", worker, dep.Name, dep.Version, 0, 0)
 
 		a := agent.NewAgent(dep, configuration)
+		a.KnowledgeBase = kb
 		results <- a.Run(cache)
 	}
 }
@@ -0,0 +1,138 @@
+package endoflifeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/a-grasso/deprec/configuration"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+type Client struct {
+	BaseURLProduct string
+	Directory      string
+}
+
+func NewClient(config configuration.EndOfLife) *Client {
+
+	baseURL := "https://endoflife.date/api"
+	if config.URL != "" {
+		baseURL = strings.TrimSuffix(config.URL, "/")
+	}
+
+	return &Client{
+		BaseURLProduct: baseURL + "/%s.json",
+		Directory:      config.Directory,
+	}
+}
+
+// Milestone is either a flag like 'eol: true' or the date it is reached like 'eol: "2024-08-31"'
+type Milestone struct {
+	Reached bool
+	Date    time.Time
+}
+
+func (m *Milestone) UnmarshalJSON(data []byte) error {
+
+	var flag bool
+	if err := json.Unmarshal(data, &flag); err == nil {
+		m.Reached = flag
+		return nil
+	}
+
+	var date string
+	if err := json.Unmarshal(data, &date); err != nil {
+		return err
+	}
+
+	t, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return err
+	}
+
+	m.Date = t
+
+	return nil
+}
+
+// ReachedBy is evaluated on demand, since cached product files outlive the dates they announce
+func (m Milestone) ReachedBy(t time.Time) bool {
+	return m.Reached || (!m.Date.IsZero() && !m.Date.After(t))
+}
+
+type Cycle struct {
+	Cycle             string
+	ReleaseDate       string
+	EOL               Milestone
+	Support           Milestone
+	Latest            string
+	LatestReleaseDate string
+	LTS               Milestone
+}
+
+func (c *Cycle) UnmarshalJSON(data []byte) error {
+
+	var raw struct {
+		Cycle             json.RawMessage `json:"cycle"`
+		ReleaseDate       string          `json:"releaseDate"`
+		EOL               Milestone       `json:"eol"`
+		Support           Milestone       `json:"support"`
+		Latest            json.RawMessage `json:"latest"`
+		LatestReleaseDate string          `json:"latestReleaseDate"`
+		LTS               Milestone       `json:"lts"`
+	}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	c.Cycle = rawString(raw.Cycle)
+	c.ReleaseDate = raw.ReleaseDate
+	c.EOL = raw.EOL
+	c.Support = raw.Support
+	c.Latest = rawString(raw.Latest)
+	c.LatestReleaseDate = raw.LatestReleaseDate
+	c.LTS = raw.LTS
+
+	return nil
+}
+
+// cycles and versions are strings in most product files, but some are plain numbers
+func rawString(raw json.RawMessage) string {
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s
+	}
+	return strings.Trim(string(raw), "\" ")
+}
+
+func (c *Client) GetProduct(product string) ([]Cycle, error) {
+
+	var cycles []Cycle
+
+	if c.Directory != "" {
+		content, err := os.ReadFile(filepath.Join(c.Directory, product+".json"))
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal(content, &cycles)
+		return cycles, err
+	}
+
+	resp, err := http.Get(fmt.Sprintf(c.BaseURLProduct, product))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("endoflife api responded with status %d for product '%s'", resp.StatusCode, product)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&cycles)
+	return cycles, err
+}
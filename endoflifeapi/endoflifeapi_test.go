@@ -0,0 +1,62 @@
+package endoflifeapi
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const product = `[
+  {"cycle": "6.0", "releaseDate": "2022-11-16", "eol": "2029-06-30", "support": "2026-06-30", "latest": "6.0.11", "lts": false},
+  {"cycle": 5, "eol": true, "support": false, "latest": "5.3.31", "lts": true}
+]`
+
+func TestCycleUnmarshal(t *testing.T) {
+
+	var cycles []Cycle
+	err := json.Unmarshal([]byte(product), &cycles)
+
+	assert.Nil(t, err)
+	assert.Len(t, cycles, 2)
+
+	assert.Equal(t, "6.0", cycles[0].Cycle)
+	assert.Equal(t, "6.0.11", cycles[0].Latest)
+	assert.Equal(t, time.Date(2029, 6, 30, 0, 0, 0, 0, time.UTC), cycles[0].EOL.Date)
+	assert.False(t, cycles[0].EOL.Reached)
+	assert.False(t, cycles[0].LTS.Reached)
+
+	assert.Equal(t, "5", cycles[1].Cycle)
+	assert.True(t, cycles[1].EOL.Reached)
+	assert.True(t, cycles[1].EOL.Date.IsZero())
+	assert.True(t, cycles[1].LTS.Reached)
+}
+
+func TestMilestoneReachedBy(t *testing.T) {
+
+	milestone := Milestone{Date: time.Date(2026, 6, 30, 0, 0, 0, 0, time.UTC)}
+
+	assert.False(t, milestone.ReachedBy(time.Date(2026, 6, 29, 0, 0, 0, 0, time.UTC)))
+	assert.True(t, milestone.ReachedBy(time.Date(2026, 6, 30, 0, 0, 0, 0, time.UTC)))
+	assert.True(t, Milestone{Reached: true}.ReachedBy(time.Time{}))
+	assert.False(t, Milestone{}.ReachedBy(time.Now()))
+}
+
+func TestGetProductFromDirectory(t *testing.T) {
+
+	directory := t.TempDir()
+	err := os.WriteFile(filepath.Join(directory, "spring-framework.json"), []byte(product), 0o644)
+	assert.Nil(t, err)
+
+	client := &Client{Directory: directory}
+
+	cycles, err := client.GetProduct("spring-framework")
+
+	assert.Nil(t, err)
+	assert.Len(t, cycles, 2)
+
+	_, err = client.GetProduct("unknown")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,35 @@
+package endoflifeapi
+
+import (
+	"context"
+	"github.com/a-grasso/deprec/cache"
+)
+
+type ClientWrapper struct {
+	Cache  *cache.Cache
+	Client *Client
+}
+
+func NewClientWrapper(client *Client, cache *cache.Cache) *ClientWrapper {
+	return &ClientWrapper{
+		Cache:  cache,
+		Client: client,
+	}
+}
+
+func (cw *ClientWrapper) GetProduct(product string) ([]Cycle, error) {
+
+	// local product files are maintained by hand and must not be shadowed by the cache
+	if cw.Client.Directory != "" {
+		return cw.Client.GetProduct(product)
+	}
+
+	coll := cw.Cache.Database("endoflife_product").Collection(product)
+
+	f := func() ([]Cycle, error) {
+		cycles, err := cw.Client.GetProduct(product)
+		return cycles, err
+	}
+
+	return cache.FetchMultiple[Cycle](context.TODO(), coll, f)
+}
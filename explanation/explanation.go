@@ -0,0 +1,237 @@
+package explanation
+
+import (
+	"fmt"
+	"github.com/a-grasso/deprec/agent"
+	"github.com/a-grasso/deprec/model"
+	"math"
+	"sort"
+	"strings"
+)
+
+const defaultLimit = 5
+
+var concerns = map[model.Recommendation]bool{model.DecisionMaking: true, model.Watchlist: true}
+
+// Item is a statement together with its share of the whole result, following the weights up the core tree
+type Item struct {
+	Statement    model.Statement
+	Contribution float64
+	Text         string
+}
+
+type Explanation struct {
+	Dependency      model.Dependency
+	Recommendation  model.Recommendation
+	Recommendations model.RecommendationDistribution
+	DecisionReason  string
+	Successors      []model.Successor
+
+	// Concerns point towards Decision Making or Watchlist, Strengths towards No Immediate Action or No Concerns
+	Concerns  []Item
+	Strengths []Item
+
+	// Limit is the number of items shown per section
+	Limit int
+}
+
+func Explain(result agent.Result) Explanation {
+
+	explanation := Explanation{
+		Dependency:      result.Dependency,
+		Recommendation:  result.TopRecommendation(),
+		Recommendations: result.Recommendations,
+		DecisionReason:  result.DecisionReason,
+		Successors:      result.Successors,
+		Limit:           defaultLimit,
+	}
+
+	var items []Item
+	contributions(result.Core, 1, &items)
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Contribution > items[j].Contribution
+	})
+
+	for _, item := range items {
+		if concerns[item.Statement.Bucket] {
+			explanation.Concerns = append(explanation.Concerns, item)
+		} else {
+			explanation.Strengths = append(explanation.Strengths, item)
+		}
+	}
+
+	return explanation
+}
+
+// contributions spreads the share of a core over its statements and underlying cores by their weights
+func contributions(core model.Core, share float64, items *[]Item) {
+
+	sum := core.Sum()
+	if sum == 0 {
+		return
+	}
+
+	for _, statement := range core.Statements {
+		*items = append(*items, Item{
+			Statement:    statement,
+			Contribution: share * statement.Weight / sum,
+			Text:         describe(statement),
+		})
+	}
+
+	for weight, underlying := range core.UnderlyingCores {
+		for _, u := range underlying {
+			if u.Sum() == 0 {
+				continue
+			}
+			contributions(u, share*weight/sum, items)
+		}
+	}
+}
+
+func describe(s model.Statement) string {
+
+	metric := s.Metric
+	if metric == "" {
+		metric = string(s.Core)
+	}
+
+	text := metric
+
+	// plain bucket intakes carry no measured value worth showing
+	if s.Limit != nil || s.Threshold != nil || !isStage(s.Value) {
+		text += fmt.Sprintf(": %s", number(s.Value))
+	}
+
+	if s.Limit != nil {
+		text += fmt.Sprintf(" (limit %s)", number(*s.Limit))
+	}
+	if s.Threshold != nil {
+		text += fmt.Sprintf(" (threshold %s)", number(*s.Threshold))
+	}
+
+	if s.Evidence != "" {
+		text += fmt.Sprintf(", %s", s.Evidence)
+	}
+
+	return text
+}
+
+func isStage(value float64) bool {
+	return value == model.NC || value == model.NIA || value == model.W || value == model.DM
+}
+
+// number renders values that could not be measured, like the closing time of issues that were never closed, as n/a
+func number(value float64) string {
+	if math.IsInf(value, 0) || math.IsNaN(value) {
+		return "n/a"
+	}
+	if value == math.Trunc(value) {
+		return fmt.Sprintf("%.0f", value)
+	}
+	return fmt.Sprintf("%.2f", value)
+}
+
+// sections orders the items so the ones backing the top recommendation come first
+func (e Explanation) sections() (string, []Item, string, []Item) {
+	if concerns[e.Recommendation] || e.Recommendation == model.Inconclusive {
+		return "Main concerns", e.Concerns, "In favour", e.Strengths
+	}
+	return "Main strengths", e.Strengths, "Concerns", e.Concerns
+}
+
+func (e Explanation) limited(items []Item) []Item {
+	if e.Limit > 0 && len(items) > e.Limit {
+		return items[:e.Limit]
+	}
+	return items
+}
+
+func (e Explanation) distribution() string {
+	r := e.Recommendations
+	return fmt.Sprintf("DM %.0f%% | W %.0f%% | NIA %.0f%% | NC %.0f%%", r[model.DecisionMaking]*100, r[model.Watchlist]*100, r[model.NoImmediateAction]*100, r[model.NoConcerns]*100)
+}
+
+func (e Explanation) name() string {
+	if e.Dependency.Version == "" {
+		return e.Dependency.Name
+	}
+	return fmt.Sprintf("%s %s", e.Dependency.Name, e.Dependency.Version)
+}
+
+func (e Explanation) Text() string {
+
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%s: %s (%s)\n", e.name(), e.Recommendation, e.distribution())
+
+	if e.DecisionReason != "" {
+		fmt.Fprintf(&b, "Reason: %s\n", e.DecisionReason)
+	}
+
+	firstTitle, first, secondTitle, second := e.sections()
+
+	for _, section := range []struct {
+		title string
+		items []Item
+	}{{firstTitle, first}, {secondTitle, second}} {
+
+		if len(section.items) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(&b, "%s:\n", section.title)
+		for _, item := range e.limited(section.items) {
+			fmt.Fprintf(&b, "  - %s [%s, %.1f%%]\n", item.Text, item.Statement.Core, item.Contribution*100)
+		}
+	}
+
+	if len(e.Successors) != 0 {
+		fmt.Fprintf(&b, "Possible successor: %s (score %.2f)\n", e.Successors[0].Name, e.Successors[0].Score)
+	}
+
+	return b.String()
+}
+
+func (e Explanation) Markdown() string {
+
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "### %s\n\n", e.name())
+	fmt.Fprintf(&b, "**%s** (%s)\n\n", e.Recommendation, e.distribution())
+
+	if e.DecisionReason != "" {
+		fmt.Fprintf(&b, "> %s\n\n", e.DecisionReason)
+	}
+
+	firstTitle, first, secondTitle, second := e.sections()
+
+	for _, section := range []struct {
+		title string
+		items []Item
+	}{{firstTitle, first}, {secondTitle, second}} {
+
+		if len(section.items) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(&b, "#### %s\n\n", section.title)
+		for _, item := range e.limited(section.items) {
+			fmt.Fprintf(&b, "- %s _(%s, %.1f%%)_\n", escape(item.Text), item.Statement.Core, item.Contribution*100)
+		}
+		b.WriteString("\n")
+	}
+
+	if len(e.Successors) != 0 {
+		fmt.Fprintf(&b, "Possible successor: `%s` (score %.2f)\n", e.Successors[0].Name, e.Successors[0].Score)
+	}
+
+	return b.String()
+}
+
+var markdownEscaper = strings.NewReplacer(`*`, `\*`, `_`, `\_`, "`", "\\`", `[`, `\[`, `]`, `\]`, `<`, `&lt;`)
+
+func escape(text string) string {
+	return markdownEscaper.Replace(text)
+}
@@ -0,0 +1,81 @@
+package explanation
+
+import (
+	"github.com/a-grasso/deprec/agent"
+	"github.com/a-grasso/deprec/model"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"strings"
+	"testing"
+)
+
+func result() agent.Result {
+
+	recentness := model.NewCore(model.Recentness)
+	recentness.Measure("Months since last commit", model.GitHubSource).Because("last commit on 2020-03-01").IntakeLimit(31, 24, 4)
+
+	marking := model.NewCore(model.Marking)
+	marking.Measure("Deprecation notice in readme", model.GitHubSource).Because("top: This project is no longer maintained").Intake(model.DM, 2)
+
+	popularity := model.NewCore(model.Popularity)
+	popularity.Measure("Repository popularity", model.GitHubSource).IntakeThreshold(5000, 1000, 1)
+
+	root := model.NewCore(model.CombCon)
+	root.Overtake(*recentness, 2)
+	root.Overtake(*marking, 1)
+	root.Overtake(*popularity, 1)
+
+	return agent.Result{
+		Dependency:      model.Dependency{Name: "left-pad", Version: "1.3.0"},
+		Core:            *root,
+		Recommendations: root.Recommend(),
+		Successors:      []model.Successor{{Name: "string.prototype.padstart", Score: 0.8}},
+	}
+}
+
+func TestExplainRanksByContribution(t *testing.T) {
+
+	explanation := Explain(result())
+
+	assert.Equal(t, model.DecisionMaking, explanation.Recommendation)
+
+	assert.Len(t, explanation.Concerns, 2)
+	assert.Equal(t, "Months since last commit: 31 (limit 24), last commit on 2020-03-01", explanation.Concerns[0].Text)
+	assert.InDelta(t, 0.5, explanation.Concerns[0].Contribution, 0.001)
+	assert.Equal(t, "Deprecation notice in readme, top: This project is no longer maintained", explanation.Concerns[1].Text)
+	assert.InDelta(t, 0.25, explanation.Concerns[1].Contribution, 0.001)
+
+	assert.Len(t, explanation.Strengths, 1)
+	assert.Equal(t, "Repository popularity: 5000 (threshold 1000)", explanation.Strengths[0].Text)
+}
+
+func TestText(t *testing.T) {
+
+	text := Explain(result()).Text()
+
+	assert.True(t, strings.HasPrefix(text, "left-pad 1.3.0: Decision Making (DM 75% | W 0% | NIA 0% | NC 25%)\n"))
+	assert.Contains(t, text, "Main concerns:\n  - Months since last commit: 31 (limit 24), last commit on 2020-03-01 [Recentness, 50.0%]\n")
+	assert.Contains(t, text, "In favour:\n  - Repository popularity: 5000 (threshold 1000) [Popularity, 25.0%]\n")
+	assert.Contains(t, text, "Possible successor: string.prototype.padstart (score 0.80)")
+	assert.Less(t, strings.Index(text, "Main concerns"), strings.Index(text, "In favour"))
+}
+
+func TestMarkdownLimitsAndEscapes(t *testing.T) {
+
+	explanation := Explain(result())
+	explanation.Limit = 1
+	explanation.Concerns[0].Text = "uses *stars*"
+
+	markdown := explanation.Markdown()
+
+	assert.Contains(t, markdown, "### left-pad 1.3.0\n\n**Decision Making**")
+	assert.Contains(t, markdown, "#### Main concerns\n\n- uses \\*stars\\* _(Recentness, 50.0%)_\n\n")
+	assert.NotContains(t, markdown, "Deprecation notice")
+}
+
+func TestNumberRendersNonFiniteValues(t *testing.T) {
+	assert.Equal(t, "31", number(31))
+	assert.Equal(t, "0.25", number(0.25))
+	assert.Equal(t, "n/a", number(math.Inf(1)))
+	assert.Equal(t, "n/a", number(math.NaN()))
+}
@@ -0,0 +1,138 @@
+package extraction
+
+import (
+	"context"
+	"fmt"
+	"github.com/a-grasso/deprec/cache"
+	"github.com/a-grasso/deprec/configuration"
+	"github.com/a-grasso/deprec/githubapi"
+	"github.com/a-grasso/deprec/logging"
+	"github.com/a-grasso/deprec/model"
+	"github.com/a-grasso/deprec/versioning"
+	"github.com/shurcooL/githubv4"
+)
+
+var ecosystems = map[string]githubv4.SecurityAdvisoryEcosystem{
+	"maven":    githubv4.SecurityAdvisoryEcosystemMaven,
+	"npm":      githubv4.SecurityAdvisoryEcosystemNpm,
+	"pypi":     githubv4.SecurityAdvisoryEcosystemPip,
+	"golang":   githubv4.SecurityAdvisoryEcosystemGo,
+	"nuget":    githubv4.SecurityAdvisoryEcosystemNuget,
+	"gem":      githubv4.SecurityAdvisoryEcosystemRubygems,
+	"cargo":    githubv4.SecurityAdvisoryEcosystemRust,
+	"composer": githubv4.SecurityAdvisoryEcosystemComposer,
+	"pub":      githubv4.SecurityAdvisoryEcosystemPub,
+	"hex":      githubv4.SecurityAdvisoryEcosystemErlang,
+}
+
+type GitHubAdvisoryExtractor struct {
+	Ecosystem githubv4.SecurityAdvisoryEcosystem
+	Package   string
+	Version   string
+	Client    *githubapi.ClientWrapper
+}
+
+func NewGitHubAdvisoryExtractor(dependency model.Dependency, config configuration.GitHub, cache *cache.Cache) (*GitHubAdvisoryExtractor, error) {
+
+	purl, err := model.ParsePackageURL(dependency.PackageURL)
+	if err != nil {
+		return nil, err
+	}
+
+	ecosystem, exists := ecosystems[purl.Type]
+	if !exists {
+		return nil, fmt.Errorf("no advisory ecosystem for package type '%s'", purl.Type)
+	}
+
+	client, err := githubapi.NewClient(config)
+	if err != nil {
+		return nil, err
+	}
+
+	version := purl.Version
+	if version == "" {
+		version = dependency.Version
+	}
+
+	return &GitHubAdvisoryExtractor{
+		Ecosystem: ecosystem,
+		Package:   advisoryPackageName(purl),
+		Version:   version,
+		Client:    githubapi.NewClientWrapper(client, cache),
+	}, nil
+}
+
+// advisoryPackageName follows the naming of the advisory database, maven coordinates are joined by a colon
+func advisoryPackageName(purl *model.PackageURL) string {
+	if purl.Type == "maven" && purl.Namespace != "" {
+		return purl.Namespace + ":" + purl.Name
+	}
+	return purl.FullName()
+}
+
+func (gae *GitHubAdvisoryExtractor) Extract(dataModel *model.DataModel) error {
+	logging.SugaredLogger.Infof("extracting advisories of '%s' in ecosystem '%s'", gae.Package, gae.Ecosystem)
+
+	vulnerabilities, err := gae.Client.GraphQL.FetchSecurityVulnerabilities(context.TODO(), gae.Ecosystem, gae.Package)
+	if err != nil {
+		return fmt.Errorf("could not extract advisories of '%s' : %w", gae.Package, err)
+	}
+
+	var advisories []model.Advisory
+
+	for _, vulnerability := range vulnerabilities {
+
+		if vulnerability.Advisory.WithdrawnAt != nil {
+			continue
+		}
+
+		var firstPatched string
+		if vulnerability.FirstPatchedVersion != nil {
+			firstPatched = vulnerability.FirstPatchedVersion.Identifier
+		}
+
+		var cves []string
+		for _, identifier := range vulnerability.Advisory.Identifiers {
+			if identifier.Type == "CVE" {
+				cves = append(cves, identifier.Value)
+			}
+		}
+
+		advisory := model.Advisory{
+			ID:                     vulnerability.Advisory.GhsaId,
+			CVEs:                   cves,
+			Summary:                vulnerability.Advisory.Summary,
+			Severity:               vulnerability.Severity,
+			VulnerableVersionRange: vulnerability.VulnerableVersionRange,
+			FirstPatchedVersion:    firstPatched,
+			PublishedAt:            vulnerability.Advisory.PublishedAt,
+			Affected:               gae.affects(vulnerability.VulnerableVersionRange),
+		}
+
+		advisories = append(advisories, advisory)
+	}
+
+	if dataModel.VulnerabilityIndex == nil {
+		dataModel.VulnerabilityIndex = &model.VulnerabilityIndex{}
+	}
+
+	dataModel.VulnerabilityIndex.Advisories = advisories
+
+	return nil
+}
+
+// an unknown version or an unreadable range is not counted as affected
+func (gae *GitHubAdvisoryExtractor) affects(vulnerableRange string) bool {
+
+	if !versioning.Parse(gae.Version).IsValid() {
+		return false
+	}
+
+	r, err := versioning.ParseRange(vulnerableRange)
+	if err != nil {
+		logging.SugaredLogger.Debugf("could not parse advisory range '%s' of '%s' : %s", vulnerableRange, gae.Package, err)
+		return false
+	}
+
+	return r.Contains(gae.Version)
+}
@@ -0,0 +1,29 @@
+package extraction
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestAffects(t *testing.T) {
+
+	tests := []struct {
+		version         string
+		vulnerableRange string
+		affected        bool
+	}{
+		{"2.14.1", "< 2.15.0", true},
+		{"2.15.0", "< 2.15.0", false},
+		{"2.14.1", ">= 2.0.0, < 2.15.0", true},
+		{"1.2.17", ">= 2.0.0, < 2.15.0", false},
+		{"1.2.17", "[1.0,2.0)", true},
+		{"stable", "< 2.15.0", false},
+		{"", "< 2.15.0", false},
+		{"2.14.1", "not a range", false},
+	}
+
+	for _, test := range tests {
+		gae := &GitHubAdvisoryExtractor{Package: "org.apache.logging.log4j:log4j-core", Version: test.version}
+		assert.Equal(t, test.affected, gae.affects(test.vulnerableRange), test.version+" "+test.vulnerableRange)
+	}
+}
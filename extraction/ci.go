@@ -0,0 +1,174 @@
+package extraction
+
+import (
+	"context"
+	"github.com/a-grasso/deprec/logging"
+	"github.com/a-grasso/deprec/model"
+	"github.com/google/go-github/v48/github"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const githubActions = "github-actions"
+
+// ciConfigs maps files and directories in the repository root to the ci system they configure
+var ciConfigs = map[string]string{
+	".travis.yml":             "travis",
+	".circleci":               "circleci",
+	".gitlab-ci.yml":          "gitlab",
+	"jenkinsfile":             "jenkins",
+	"azure-pipelines.yml":     "azure-pipelines",
+	"appveyor.yml":            "appveyor",
+	".appveyor.yml":           "appveyor",
+	".drone.yml":              "drone",
+	"bitbucket-pipelines.yml": "bitbucket",
+	".buildkite":              "buildkite",
+	".cirrus.yml":             "cirrus",
+	".woodpecker.yml":         "woodpecker",
+}
+
+var testDirectories = []string{"test", "tests", "__tests__", "spec", "specs", "testing", "e2e"}
+
+// testFiles are named like 'foo_test.go', 'foo.spec.ts', 'test_foo.py' or 'FooTest.java'
+var testFiles = regexp.MustCompile(`^(test_.+\.py|.+[._-](test|spec)\.[a-z]+|.+[a-z0-9]Tests?\.(java|kt|scala|groovy|cs))$`)
+
+func (ghe *GitHubExtractor) extractCI(owner, repo, branch string) *model.CI {
+
+	ci := &model.CI{}
+
+	root := ghe.listDirectory(owner, repo, "")
+
+	directories := []string{""}
+	if ghe.Subpath != "" {
+		directories = append(directories, ghe.Subpath)
+	}
+
+	for _, directory := range directories {
+
+		content := root
+		if directory != "" {
+			content = ghe.listDirectory(owner, repo, directory)
+		}
+
+		for _, entry := range content {
+			if directory == "" {
+				if system, exists := ciConfigs[strings.ToLower(entry.GetName())]; exists {
+					ci.Systems = appendUnique(ci.Systems, system)
+				}
+			}
+
+			if isTest(entry) {
+				ci.Tests = append(ci.Tests, entry.GetPath())
+			}
+
+			// maven and gradle keep their tests next to the sources, others beside the source files
+			if entry.GetType() == "dir" && entry.GetName() == "src" {
+				ci.TestsExpected = true
+				for _, source := range ghe.listDirectory(owner, repo, entry.GetPath()) {
+					if isTest(source) {
+						ci.Tests = append(ci.Tests, source.GetPath())
+					}
+				}
+			}
+		}
+	}
+
+	workflows, err := ghe.Client.Actions.ListWorkflows(context.TODO(), owner, repo)
+	if err != nil {
+		logging.SugaredLogger.Debugf("could not extract workflows of '%s' : %s", ghe.RepositoryURL, err)
+		return ci
+	}
+
+	ci.Workflows = len(workflows)
+	if ci.Workflows == 0 {
+		return ci
+	}
+
+	ci.Systems = appendUnique(ci.Systems, githubActions)
+
+	runs, err := ghe.Client.Actions.ListRecentWorkflowRuns(context.TODO(), owner, repo, branch)
+	if err != nil {
+		logging.SugaredLogger.Debugf("could not extract workflow runs of '%s' : %s", ghe.RepositoryURL, err)
+		return ci
+	}
+
+	summarizeRuns(ci, runs)
+
+	return ci
+}
+
+func (ghe *GitHubExtractor) listDirectory(owner, repo, directory string) []*github.RepositoryContent {
+	content, err := ghe.Client.Repositories.ListDirectory(context.TODO(), owner, repo, directory)
+	if err != nil {
+		logging.SugaredLogger.Debugf("could not list directory '%s' of '%s' : %s", directory, ghe.RepositoryURL, err)
+		return nil
+	}
+	return content
+}
+
+// summarizeRuns looks at completed runs only, cancelled and skipped runs neither break nor extend a failure streak
+func summarizeRuns(ci *model.CI, runs []*github.WorkflowRun) {
+
+	sort.SliceStable(runs, func(i, j int) bool {
+		return runs[i].GetCreatedAt().After(runs[j].GetCreatedAt().Time)
+	})
+
+	streak := true
+
+	for _, run := range runs {
+
+		if run.GetStatus() != "completed" {
+			continue
+		}
+
+		created := run.GetCreatedAt().Time
+
+		ci.Runs++
+		if ci.LastRun == nil {
+			ci.LastRun = toDate(created)
+		}
+
+		switch run.GetConclusion() {
+		case "success":
+			if ci.LastSuccess == nil {
+				ci.LastSuccess = toDate(created)
+			}
+			streak = false
+		case "failure", "timed_out", "startup_failure":
+			if streak {
+				ci.FailureStreak++
+			}
+		}
+	}
+}
+
+func isTest(entry *github.RepositoryContent) bool {
+	switch entry.GetType() {
+	case "dir":
+		return isTestDirectory(entry.GetName())
+	case "file":
+		return testFiles.MatchString(path.Base(entry.GetName()))
+	}
+	return false
+}
+
+func isTestDirectory(name string) bool {
+	name = strings.ToLower(path.Base(name))
+	for _, directory := range testDirectories {
+		if name == directory {
+			return true
+		}
+	}
+	return false
+}
+
+func appendUnique(values []string, value string) []string {
+	for _, v := range values {
+		if v == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
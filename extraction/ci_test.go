@@ -0,0 +1,75 @@
+package extraction
+
+import (
+	"github.com/a-grasso/deprec/model"
+	"github.com/google/go-github/v48/github"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestSummarizeRuns(t *testing.T) {
+
+	day := func(d int) time.Time { return time.Date(2023, 1, d, 0, 0, 0, 0, time.UTC) }
+
+	run := func(d int, status, conclusion string) *github.WorkflowRun {
+		return &github.WorkflowRun{
+			Status:     github.String(status),
+			Conclusion: github.String(conclusion),
+			CreatedAt:  &github.Timestamp{Time: day(d)},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		runs          []*github.WorkflowRun
+		count         int
+		lastRun       *time.Time
+		lastSuccess   *time.Time
+		failureStreak int
+	}{
+		{"no runs", nil, 0, nil, nil, 0},
+		{"green", []*github.WorkflowRun{run(1, "completed", "success"), run(2, "completed", "success")}, 2, toDate(day(2)), toDate(day(2)), 0},
+		{"broken", []*github.WorkflowRun{run(1, "completed", "success"), run(3, "completed", "failure"), run(2, "completed", "timed_out")}, 3, toDate(day(3)), toDate(day(1)), 2},
+		{"cancelled runs do not break a streak", []*github.WorkflowRun{run(1, "completed", "failure"), run(2, "completed", "cancelled"), run(3, "completed", "failure")}, 3, toDate(day(3)), nil, 2},
+		{"running runs are ignored", []*github.WorkflowRun{run(1, "completed", "success"), run(2, "in_progress", "")}, 1, toDate(day(1)), toDate(day(1)), 0},
+	}
+
+	for _, test := range tests {
+		ci := &model.CI{}
+		summarizeRuns(ci, test.runs)
+
+		assert.Equal(t, test.count, ci.Runs, test.name)
+		assert.Equal(t, test.lastRun, ci.LastRun, test.name)
+		assert.Equal(t, test.lastSuccess, ci.LastSuccess, test.name)
+		assert.Equal(t, test.failureStreak, ci.FailureStreak, test.name)
+	}
+}
+
+func TestIsTest(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		kind  string
+		match bool
+	}{
+		{"test", "dir", true},
+		{"__tests__", "dir", true},
+		{"testdata", "dir", false},
+		{"main_test.go", "file", true},
+		{"main.go", "file", false},
+		{"index.test.js", "file", true},
+		{"button.spec.tsx", "file", true},
+		{"test_parser.py", "file", true},
+		{"ParserTest.java", "file", true},
+		{"ParserTests.cs", "file", true},
+		{"latest.js", "file", false},
+		{"Contest.java", "file", false},
+		{"main_test.go", "symlink", false},
+	}
+
+	for _, test := range tests {
+		entry := &github.RepositoryContent{Name: github.String(test.name), Type: github.String(test.kind)}
+		assert.Equal(t, test.match, isTest(entry), test.name)
+	}
+}
@@ -0,0 +1,173 @@
+package extraction
+
+import (
+	"errors"
+	"fmt"
+	"github.com/a-grasso/deprec/cache"
+	"github.com/a-grasso/deprec/configuration"
+	"github.com/a-grasso/deprec/endoflifeapi"
+	"github.com/a-grasso/deprec/logging"
+	"github.com/a-grasso/deprec/model"
+	"github.com/a-grasso/deprec/statistics"
+	"sort"
+	"strings"
+	"time"
+)
+
+// defaultProducts maps package urls without version to endoflife.date products, a trailing '*' matches any suffix
+var defaultProducts = map[string]string{
+	"pkg:maven/org.springframework/*":            "spring-framework",
+	"pkg:maven/org.springframework.boot/*":       "spring-boot",
+	"pkg:maven/org.apache.logging.log4j/*":       "log4j",
+	"pkg:maven/log4j/log4j":                      "log4j",
+	"pkg:maven/org.apache.tomcat/*":              "tomcat",
+	"pkg:maven/org.apache.tomcat.embed/*":        "tomcat",
+	"pkg:npm/@angular/core":                      "angular",
+	"pkg:npm/react":                              "react",
+	"pkg:npm/vue":                                "vue",
+	"pkg:npm/jquery":                             "jquery",
+	"pkg:npm/bootstrap":                          "bootstrap",
+	"pkg:pypi/django":                            "django",
+	"pkg:gem/rails":                              "rails",
+	"pkg:composer/symfony/symfony":               "symfony",
+	"pkg:composer/laravel/framework":             "laravel",
+	"pkg:nuget/Microsoft.NETCore.App":            "dotnet",
+	"pkg:maven/org.eclipse.jetty/*":              "eclipse-jetty",
+	"pkg:maven/org.hibernate.orm/hibernate-core": "hibernate",
+	"pkg:maven/org.hibernate/hibernate-core":     "hibernate",
+}
+
+type EndOfLifeExtractor struct {
+	Product string
+	Version string
+	Client  *endoflifeapi.ClientWrapper
+}
+
+func NewEndOfLifeExtractor(dependency model.Dependency, config configuration.EndOfLife, cache *cache.Cache) (*EndOfLifeExtractor, error) {
+
+	product, version := matchProduct(dependency, config.Products)
+	if product == "" {
+		return nil, fmt.Errorf("no lifecycle product known for '%s'", dependency.Name)
+	}
+
+	client := endoflifeapi.NewClient(config)
+
+	wrapper := endoflifeapi.NewClientWrapper(client, cache)
+
+	return &EndOfLifeExtractor{
+		Product: product,
+		Version: version,
+		Client:  wrapper,
+	}, nil
+}
+
+func (eole *EndOfLifeExtractor) Extract(dataModel *model.DataModel) error {
+	logging.SugaredLogger.Infof("extracting lifecycle of '%s' in version '%s'", eole.Product, eole.Version)
+
+	cycles, err := eole.Client.GetProduct(eole.Product)
+	if err != nil {
+		return fmt.Errorf("could not get lifecycle product '%s' : %w", eole.Product, err)
+	}
+
+	cycle, err := matchCycle(cycles, eole.Version)
+	if err != nil {
+		return fmt.Errorf("could not match lifecycle of '%s' : %w", eole.Product, err)
+	}
+
+	// same clock as the cores evaluating the lifecycle
+	now := statistics.CustomNow()
+
+	dataModel.Lifecycle = &model.Lifecycle{
+		Product:        eole.Product,
+		Cycle:          cycle.Cycle,
+		LatestInCycle:  cycle.Latest,
+		LTS:            cycle.LTS.Reached || !cycle.LTS.Date.IsZero(),
+		EndOfLife:      cycle.EOL.ReachedBy(now),
+		EndOfLifeDate:  toDate(cycle.EOL.Date),
+		EndOfSupport:   cycle.Support.ReachedBy(now),
+		SupportedUntil: toDate(cycle.Support.Date),
+	}
+
+	return nil
+}
+
+// matchProduct prefers configured mappings over the defaults and purls over names
+func matchProduct(dependency model.Dependency, configured map[string]string) (string, string) {
+
+	version := dependency.Version
+
+	var candidates []string
+
+	if purl, err := model.ParsePackageURL(dependency.PackageURL); err == nil {
+		candidates = append(candidates, fmt.Sprintf("pkg:%s/%s", purl.Type, purl.FullName()))
+		if purl.Version != "" {
+			version = purl.Version
+		}
+	}
+	candidates = append(candidates, dependency.Name)
+
+	for _, mappings := range []map[string]string{configured, defaultProducts} {
+		for _, candidate := range candidates {
+			if product := lookupProduct(mappings, candidate); product != "" {
+				return product, version
+			}
+		}
+	}
+
+	return "", version
+}
+
+// lookupProduct picks the most specific pattern so that overlapping wildcards resolve deterministically
+func lookupProduct(mappings map[string]string, candidate string) string {
+
+	var patterns []string
+	for pattern := range mappings {
+		patterns = append(patterns, pattern)
+	}
+	sort.Slice(patterns, func(i, j int) bool { return len(patterns[i]) > len(patterns[j]) })
+
+	for _, pattern := range patterns {
+		if strings.HasSuffix(pattern, "*") && strings.HasPrefix(strings.ToLower(candidate), strings.ToLower(strings.TrimSuffix(pattern, "*"))) {
+			return mappings[pattern]
+		}
+		if strings.EqualFold(pattern, candidate) {
+			return mappings[pattern]
+		}
+	}
+
+	return ""
+}
+
+// matchCycle returns the most specific cycle the version belongs to, e.g. '5.3' for '5.3.27' rather than '5'
+func matchCycle(cycles []endoflifeapi.Cycle, version string) (*endoflifeapi.Cycle, error) {
+
+	if version == "" {
+		return nil, errors.New("no version to match a cycle")
+	}
+
+	var result *endoflifeapi.Cycle
+	for i, cycle := range cycles {
+		if cycle.Cycle == "" {
+			continue
+		}
+		if version != cycle.Cycle && !strings.HasPrefix(version, cycle.Cycle+".") {
+			continue
+		}
+		if result == nil || len(cycle.Cycle) > len(result.Cycle) {
+			result = &cycles[i]
+		}
+	}
+
+	if result == nil {
+		return nil, fmt.Errorf("no cycle matches version '%s'", version)
+	}
+
+	return result, nil
+}
+
+func toDate(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
@@ -0,0 +1,71 @@
+package extraction
+
+import (
+	"github.com/a-grasso/deprec/model"
+	"net/url"
+	"strings"
+)
+
+// platforms use the naming of github's funding links, anything else is a custom link
+var platforms = map[string]string{
+	"github.com":           "GITHUB",
+	"patreon.com":          "PATREON",
+	"opencollective.com":   "OPEN_COLLECTIVE",
+	"ko-fi.com":            "KO_FI",
+	"tidelift.com":         "TIDELIFT",
+	"liberapay.com":        "LIBERAPAY",
+	"issuehunt.io":         "ISSUEHUNT",
+	"crowdfunding.lfx.dev": "LFX_CROWDFUNDING",
+}
+
+// mergePackageFunding adds the funding links of the package metadata that the repository does not list already
+func mergePackageFunding(funding *model.Funding, links []string) *model.Funding {
+
+	if len(links) == 0 {
+		return funding
+	}
+
+	if funding == nil {
+		funding = &model.Funding{}
+	}
+
+	known := make(map[string]bool)
+	for _, link := range funding.Links {
+		known[normalizeFundingURL(link.URL)] = true
+	}
+
+	for _, link := range links {
+		if known[normalizeFundingURL(link)] {
+			continue
+		}
+		known[normalizeFundingURL(link)] = true
+
+		funding.Links = append(funding.Links, model.FundingLink{Platform: fundingPlatform(link), URL: link, Source: model.PackageMetadata})
+	}
+
+	return funding
+}
+
+func fundingPlatform(link string) string {
+
+	u, err := url.Parse(link)
+	if err != nil {
+		return "CUSTOM"
+	}
+
+	host := strings.TrimPrefix(strings.ToLower(u.Host), "www.")
+
+	if platform, exists := platforms[host]; exists {
+		return platform
+	}
+
+	return "CUSTOM"
+}
+
+func normalizeFundingURL(link string) string {
+	link = strings.ToLower(strings.TrimSpace(link))
+	link = strings.TrimPrefix(link, "https://")
+	link = strings.TrimPrefix(link, "http://")
+	link = strings.TrimPrefix(link, "www.")
+	return strings.TrimSuffix(link, "/")
+}
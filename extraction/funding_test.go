@@ -0,0 +1,39 @@
+package extraction
+
+import (
+	"github.com/a-grasso/deprec/model"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestMergePackageFunding(t *testing.T) {
+
+	repository := func() *model.Funding {
+		return &model.Funding{Links: []model.FundingLink{{Platform: "GITHUB", URL: "https://github.com/sponsors/o", Source: model.FundingFile}}}
+	}
+
+	tests := []struct {
+		name    string
+		funding *model.Funding
+		links   []string
+		result  []model.FundingLink
+	}{
+		{"nothing to merge", nil, nil, nil},
+		{"package only", nil, []string{"https://opencollective.com/p"}, []model.FundingLink{
+			{Platform: "OPEN_COLLECTIVE", URL: "https://opencollective.com/p", Source: model.PackageMetadata},
+		}},
+		{"known links are kept once", repository(), []string{"http://www.github.com/sponsors/o/", "https://example.com/donate", "https://example.com/donate"}, []model.FundingLink{
+			{Platform: "GITHUB", URL: "https://github.com/sponsors/o", Source: model.FundingFile},
+			{Platform: "CUSTOM", URL: "https://example.com/donate", Source: model.PackageMetadata},
+		}},
+	}
+
+	for _, test := range tests {
+		funding := mergePackageFunding(test.funding, test.links)
+		if test.result == nil {
+			assert.Nil(t, funding, test.name)
+			continue
+		}
+		assert.Equal(t, test.result, funding.Links, test.name)
+	}
+}
@@ -2,6 +2,7 @@ package extraction
 
 import (
 	"context"
+	"fmt"
 	"github.com/a-grasso/deprec/cache"
 	"github.com/a-grasso/deprec/configuration"
 	"github.com/a-grasso/deprec/githubapi"
@@ -9,6 +10,7 @@ import (
 	"github.com/a-grasso/deprec/model"
 	"github.com/google/go-github/v48/github"
 	"github.com/thoas/go-funk"
+	"path"
 	"strings"
 	"time"
 )
@@ -17,6 +19,8 @@ type GitHubExtractor struct {
 	RepositoryURL string
 	Repository    string
 	Owner         string
+	Subpath       string
+	ModuleNames   []string
 	Config        configuration.GitHub
 	Client        *githubapi.ClientWrapper
 }
@@ -33,7 +37,15 @@ func NewGitHubExtractor(dependency model.Dependency, config configuration.GitHub
 	vcs := dependency.ExternalReferences["vcs"]
 	owner, repo := parseVCSString(vcs)
 
-	return &GitHubExtractor{RepositoryURL: vcs, Owner: owner, Repository: repo, Config: config, Client: clientWrapper}, nil
+	return &GitHubExtractor{
+		RepositoryURL: vcs,
+		Owner:         owner,
+		Repository:    repo,
+		Subpath:       parseSubpath(vcs),
+		ModuleNames:   moduleNames(dependency),
+		Config:        config,
+		Client:        clientWrapper,
+	}, nil
 }
 
 func (ghe *GitHubExtractor) checkRateLimits() {
@@ -51,7 +63,43 @@ func parseVCSString(vcs string) (string, string) {
 	return splits[3], splits[4]
 }
 
-func (ghe *GitHubExtractor) Extract(dataModel *model.DataModel) {
+// parseSubpath finds the directory within the repository, e.g. 'modules/core' of 'https://github.com/o/r/tree/main/modules/core'
+// or of 'https://github.com/o/r/modules/core', which is how maven appends module names to an inherited scm url
+func parseSubpath(url string) string {
+
+	i := strings.Index(url, "github.com/")
+	if i < 0 {
+		return ""
+	}
+
+	segments := strings.Split(strings.Trim(url[i+len("github.com/"):], "/"), "/")
+	if len(segments) <= 2 {
+		return ""
+	}
+
+	path := segments[2:]
+	if path[0] == "tree" || path[0] == "blob" {
+		if len(path) <= 2 {
+			return ""
+		}
+		path = path[2:]
+	}
+
+	return strings.Join(path, "/")
+}
+
+func moduleNames(dependency model.Dependency) []string {
+
+	names := []string{dependency.Name}
+
+	if purl, err := model.ParsePackageURL(dependency.PackageURL); err == nil && purl.Name != dependency.Name {
+		names = append(names, purl.Name)
+	}
+
+	return names
+}
+
+func (ghe *GitHubExtractor) Extract(dataModel *model.DataModel) error {
 	logging.SugaredLogger.Infof("extracting repo '%s'", ghe.RepositoryURL)
 
 	ghe.checkRateLimits()
@@ -59,7 +107,16 @@ func (ghe *GitHubExtractor) Extract(dataModel *model.DataModel) {
 	repositoryData := ghe.extractRepositoryData(ghe.Owner, ghe.Repository)
 
 	if repositoryData == nil {
-		return
+		return fmt.Errorf("could not extract repository '%s'", ghe.RepositoryURL)
+	}
+
+	if repositoryData.MovedTo != "" {
+		ghe.Owner = repositoryData.Owner
+		ghe.Repository = repositoryData.Name
+	}
+
+	if ghe.Subpath == "" {
+		ghe.Subpath = ghe.subpathFromSCM(dataModel)
 	}
 
 	contributors := ghe.extractContributors(ghe.Owner, ghe.Repository)
@@ -71,19 +128,41 @@ func (ghe *GitHubExtractor) Extract(dataModel *model.DataModel) {
 		releases = ghe.extractTags(ghe.Owner, ghe.Repository)
 	}
 
-	issues := ghe.extractIssues(ghe.Owner, ghe.Repository)
+	if ghe.Subpath != "" {
+		releases = scopeReleases(releases, append(ghe.ModuleNames, path.Base(ghe.Subpath)))
+		contributors = scopeContributors(contributors, commits)
+	}
+
+	issues, pullRequestIssues := ghe.extractIssues(ghe.Owner, ghe.Repository)
+
+	pullRequests := ghe.extractPullRequests(ghe.Owner, ghe.Repository)
+	oldestOpenPullRequest := ghe.extractOldestOpenPullRequest(ghe.Owner, ghe.Repository)
+
+	ci := ghe.extractCI(ghe.Owner, ghe.Repository, repositoryData.DefaultBranch)
 
 	repository := &model.Repository{
 		Contributors:   contributors,
 		Issues:         issues,
 		Commits:        commits,
 		Releases:       releases,
+		PullRequests:   pullRequests,
 		RepositoryData: repositoryData,
+		CI:             ci,
+
+		OldestOpenPullRequest: oldestOpenPullRequest,
+		PullRequestIssues:     pullRequestIssues,
+		Subpath:               ghe.Subpath,
+	}
+
+	if distribution := dataModel.Distribution; distribution != nil && distribution.Artifact != nil {
+		repositoryData.Funding = mergePackageFunding(repositoryData.Funding, distribution.Artifact.FundingLinks)
 	}
 
 	dataModel.Repository = repository
 
 	ghe.checkRateLimits()
+
+	return nil
 }
 
 func (ghe *GitHubExtractor) calculateLinesOfCode(stats []*github.ContributorStats) int {
@@ -107,6 +186,12 @@ func (ghe *GitHubExtractor) extractRepositoryData(owner, repo string) *model.Rep
 		return nil
 	}
 
+	movedTo := detectMove(owner, repo, repository)
+	if movedTo != "" {
+		logging.SugaredLogger.Infof("repository '%s/%s' has moved to '%s'", owner, repo, movedTo)
+		owner, repo = repository.GetOwner().GetLogin(), repository.GetName()
+	}
+
 	readme := ghe.extractReadMe(owner, repo)
 
 	contributorStats := ghe.listContributorStats(owner, repo)
@@ -115,28 +200,94 @@ func (ghe *GitHubExtractor) extractRepositoryData(owner, repo string) *model.Rep
 	org := ghe.extractOrganization(repository.GetOrganization().GetLogin())
 
 	repositoryData := &model.RepositoryData{
-		Name:         repository.GetName(),
-		Owner:        repository.GetOwner().GetLogin(),
-		Org:          org,
-		CreatedAt:    repository.GetCreatedAt().Time,
-		Size:         repository.GetSize(),
-		License:      repository.GetLicense().GetKey(),
-		AllowForking: repository.GetAllowForking(),
-		ReadMe:       readme,
-		About:        repository.GetDescription(),
-		Archivation:  repository.GetArchived(),
-		Disabled:     repository.GetDisabled(),
-		LOC:          loc,
-		Forks:        repository.GetForksCount(),
-		Watchers:     repository.GetSubscribersCount(),
-		Stars:        repository.GetStargazersCount(),
-		Dependencies: nil,
-		Dependents:   nil,
+		Name:          repository.GetName(),
+		Owner:         repository.GetOwner().GetLogin(),
+		FullName:      repository.GetFullName(),
+		MovedTo:       movedTo,
+		Org:           org,
+		CreatedAt:     repository.GetCreatedAt().Time,
+		Size:          repository.GetSize(),
+		DefaultBranch: repository.GetDefaultBranch(),
+		License:       repository.GetLicense().GetKey(),
+		AllowForking:  repository.GetAllowForking(),
+		ReadMe:        readme,
+		About:         repository.GetDescription(),
+		Archivation:   repository.GetArchived(),
+		Disabled:      repository.GetDisabled(),
+		LOC:           loc,
+		Forks:         repository.GetForksCount(),
+		Watchers:      repository.GetSubscribersCount(),
+		Stars:         repository.GetStargazersCount(),
+		Fork:          repository.GetFork(),
+		ForkOf:        repository.GetParent().GetFullName(),
+		ForkSource:    repository.GetSource().GetFullName(),
+		Dependencies:  nil,
+		Dependents:    nil,
 	}
 
+	ghe.extractSecurityPosture(owner, repo, repositoryData)
+
+	repositoryData.Funding = ghe.extractFunding(owner, repo)
+
 	return repositoryData
 }
 
+func (ghe *GitHubExtractor) extractSecurityPosture(owner, repo string, repositoryData *model.RepositoryData) {
+
+	policy, err := ghe.Client.GraphQL.FetchSecurityPolicy(context.TODO(), owner, repo)
+	if err != nil {
+		logging.SugaredLogger.Debugf("could not extract security policy of '%s' : %s", ghe.RepositoryURL, err)
+	} else {
+		repositoryData.SecurityPolicy = policy.IsSecurityPolicyEnabled
+		repositoryData.SecurityPolicyURL = policy.SecurityPolicyUrl
+	}
+
+	reporting, err := ghe.Client.Repositories.GetPrivateVulnerabilityReporting(context.TODO(), owner, repo)
+	if err != nil {
+		logging.SugaredLogger.Debugf("could not extract private vulnerability reporting of '%s' : %s", ghe.RepositoryURL, err)
+	} else {
+		repositoryData.PrivateVulnerabilityReporting = &reporting.Enabled
+	}
+
+	alerts, err := ghe.Client.GraphQL.FetchVulnerabilityAlerts(context.TODO(), owner, repo)
+	if err != nil {
+		logging.SugaredLogger.Debugf("could not extract vulnerability alerts of '%s' : %s", ghe.RepositoryURL, err)
+	} else {
+		repositoryData.VulnerabilityAlerts = &alerts.HasVulnerabilityAlertsEnabled
+	}
+}
+
+func (ghe *GitHubExtractor) extractFunding(owner, repo string) *model.Funding {
+	funding, err := ghe.Client.GraphQL.FetchFunding(context.TODO(), owner, repo)
+	if err != nil {
+		logging.SugaredLogger.Debugf("could not extract funding of '%s' : %s", ghe.RepositoryURL, err)
+		return nil
+	}
+
+	links := funk.Map(funding.FundingLinks, func(l githubapi.FundingLink) model.FundingLink {
+		return model.FundingLink{Platform: l.Platform, URL: l.Url, Source: model.FundingFile}
+	}).([]model.FundingLink)
+
+	return &model.Funding{
+		Links:           links,
+		SponsorsListing: funding.Sponsorable.HasSponsorsListing,
+		Sponsors:        funding.Sponsorable.SponsorshipsAsMaintainer.TotalCount,
+	}
+}
+
+func detectMove(owner, repo string, repository *github.Repository) string {
+	fullName := repository.GetFullName()
+	if fullName == "" {
+		return ""
+	}
+
+	if strings.EqualFold(fullName, fmt.Sprintf("%s/%s", owner, repo)) {
+		return ""
+	}
+
+	return fullName
+}
+
 func (ghe *GitHubExtractor) extractReadMe(owner, repo string) string {
 	readme, err := ghe.Client.Repositories.GetReadMe(context.TODO(), owner, repo, &github.RepositoryContentGetOptions{})
 	if err != nil {
@@ -165,6 +316,7 @@ func (ghe *GitHubExtractor) extractReleases(owner, repo string) []model.Release
 
 		r := model.Release{
 			Author:      release.GetAuthor().GetLogin(),
+			Tag:         release.GetTagName(),
 			Version:     release.GetName(),
 			Description: release.GetBody(),
 			Date:        release.GetPublishedAt().Time,
@@ -202,6 +354,7 @@ func (ghe *GitHubExtractor) extractTags(owner, repo string) []model.Release {
 
 			r := model.Release{
 				Author:      tagCommit.GetCommit().GetAuthor().GetEmail(),
+				Tag:         tag.GetName(),
 				Version:     tag.GetName(),
 				Description: tagCommit.GetCommit().GetMessage(),
 				Date:        tagCommit.GetCommit().GetCommitter().GetDate(),
@@ -213,6 +366,7 @@ func (ghe *GitHubExtractor) extractTags(owner, repo string) []model.Release {
 
 			r := model.Release{
 				Author:      tag.GetCommit().GetAuthor().GetLogin(),
+				Tag:         tag.GetName(),
 				Version:     tag.GetName(),
 				Description: tag.GetCommit().GetMessage(),
 				Date:        tag.GetCommit().GetCommitter().GetDate(),
@@ -224,43 +378,25 @@ func (ghe *GitHubExtractor) extractTags(owner, repo string) []model.Release {
 	return result
 }
 
-func (ghe *GitHubExtractor) extractIssues(owner, repo string) []model.Issue {
+func (ghe *GitHubExtractor) extractIssues(owner, repo string) ([]model.Issue, []model.Issue) {
 	issues, err := ghe.Client.Issues.ListByRepo(context.TODO(), owner, repo, &github.IssueListByRepoOptions{
 		State: "all",
 	})
 	if err != nil {
 		logging.SugaredLogger.Debugf("could not extract issues of '%s' : %s", ghe.RepositoryURL, err)
-		return nil
+		return nil, nil
+	}
+
+	// without timelines issues keep the comment count of the rest api, only the responses are unknown
+	timelines, err := ghe.Client.GraphQL.FetchIssueTimelines(context.TODO(), owner, repo, issues)
+	if err != nil {
+		logging.SugaredLogger.Warnf("could not extract issue comments of '%s' : %s", ghe.RepositoryURL, err)
 	}
 
-	var result []model.Issue
+	var result, pullRequests []model.Issue
 
 	for _, issue := range issues {
 
-		//var issueContributors []string
-		//var firstResponse time.Time
-		// if issue.GetComments() != 0 {
-		// 	sort := "created"
-		// 	comments, err := ghe.Client.Issues.ListComments(context.TODO(), owner, repo, issue.GetNumber(), &github.IssueListCommentsOptions{
-		// 		Sort: &sort,
-		// 	})
-		//
-		// 	if err != nil {
-		// 		logging.SugaredLogger.Debugf("could not extract comments of issue '%d' for repo '%s'", issue.Number, repo)
-		// 	}
-		//
-		// 	firstResponse = comments[0].GetCreatedAt()
-		//
-		// 	commentators := funk.Map(comments, func(comment *github.IssueComment) string { return comment.GetUser().GetLogin() })
-		//
-		// 	issueContributors = funk.Uniq(commentators).([]string)
-		// }
-
-		var contributions []model.IssueContribution
-		for i := 0; i < issue.GetComments(); i++ {
-			contributions = append(contributions, model.IssueContribution{Time: issue.GetCreatedAt()})
-		}
-
 		i := model.Issue{
 			Number:            issue.GetNumber(),
 			Author:            issue.GetUser().GetLogin(),
@@ -269,22 +405,157 @@ func (ghe *GitHubExtractor) extractIssues(owner, repo string) []model.Issue {
 			Title:             issue.GetTitle(),
 			Content:           issue.GetBody(),
 			ClosedBy:          issue.GetClosedBy().GetLogin(),
-			Contributions:     contributions,
-			Contributors:      nil,
+			Comments:          issue.GetComments(),
 			CreationTime:      issue.GetCreatedAt(),
-			FirstResponse:     nil,
 			LastUpdate:        issue.GetUpdatedAt(),
 			ClosingTime:       issue.GetClosedAt(),
+			Bot:               isBot(issue.GetUser()),
+		}
+
+		if timeline, exists := timelines[i.Number]; exists {
+			i.Comments = timeline.Comments.TotalCount
+			collectIssueComments(&i, timeline.Comments.Nodes)
+		}
+
+		if issue.IsPullRequest() {
+			pullRequests = append(pullRequests, i)
+			continue
 		}
 
 		result = append(result, i)
 	}
 
+	return result, pullRequests
+}
+
+// isBot only trusts the account type, login and message patterns are applied by the bot detector
+func isBot(user *github.User) bool {
+	return user.GetType() == "Bot"
+}
+
+// collectIssueComments expects comments in chronological order, as returned by the api
+func collectIssueComments(issue *model.Issue, comments []githubapi.IssueComment) {
+
+	for _, comment := range comments {
+
+		author := comment.Author.Login
+
+		issue.Contributions = append(issue.Contributions, model.IssueContribution{
+			Time:              comment.CreatedAt,
+			Author:            author,
+			AuthorAssociation: comment.AuthorAssociation,
+			Bot:               comment.Author.Typename == "Bot",
+		})
+
+		if author != "" && !funk.ContainsString(issue.Contributors, author) {
+			issue.Contributors = append(issue.Contributors, author)
+		}
+	}
+
+	issue.UpdateResponses()
+}
+
+func (ghe *GitHubExtractor) extractPullRequests(owner, repo string) []model.PullRequest {
+	pullRequests, err := ghe.Client.GraphQL.FetchPullRequests(context.TODO(), owner, repo)
+	if err != nil {
+		logging.SugaredLogger.Debugf("could not extract pull requests of '%s' : %s", ghe.RepositoryURL, err)
+		return nil
+	}
+
+	var result []model.PullRequest
+
+	for _, pr := range pullRequests {
+		result = append(result, toPullRequest(pr))
+	}
+
 	return result
 }
 
+func (ghe *GitHubExtractor) extractOldestOpenPullRequest(owner, repo string) *model.PullRequest {
+	pullRequest, err := ghe.Client.GraphQL.FetchOldestOpenPullRequest(context.TODO(), owner, repo)
+	if err != nil {
+		logging.SugaredLogger.Debugf("could not extract oldest open pull request of '%s' : %s", ghe.RepositoryURL, err)
+		return nil
+	}
+
+	if pullRequest == nil {
+		return nil
+	}
+
+	result := toPullRequest(*pullRequest)
+	return &result
+}
+
+func toPullRequest(pr githubapi.PullRequest) model.PullRequest {
+
+	var firstReview *time.Time
+	if reviews := pr.Reviews.Nodes; len(reviews) != 0 {
+		firstReview = reviews[0].SubmittedAt
+	}
+
+	return model.PullRequest{
+		Number:            pr.Number,
+		Author:            pr.Author.Login,
+		AuthorAssociation: pr.AuthorAssociation,
+		State:             model.PullRequestState(pr.State),
+		CreationTime:      pr.CreatedAt,
+		MergeTime:         pr.MergedAt,
+		ClosingTime:       pr.ClosedAt,
+		Reviews:           pr.Reviews.TotalCount,
+		FirstReview:       firstReview,
+		Comments:          pr.Comments.TotalCount,
+		Bot:               pr.Author.Typename == "Bot",
+	}
+}
+
+// extractCommits scopes the history to the subpath, a subpath without any commits is assumed to be wrong and dropped
 func (ghe *GitHubExtractor) extractCommits(owner, repo string) []model.Commit {
-	commits, err := ghe.Client.Repositories.ListCommits(context.TODO(), owner, repo, &github.CommitsListOptions{})
+
+	if ghe.Subpath != "" {
+		if commits := ghe.extractHistory(owner, repo, ghe.Subpath); len(commits) != 0 {
+			return commits
+		}
+
+		logging.SugaredLogger.Infof("no commits found under '%s' of '%s', using the whole repository", ghe.Subpath, ghe.RepositoryURL)
+		ghe.Subpath = ""
+	}
+
+	return ghe.extractHistory(owner, repo, "")
+}
+
+// extractHistory leaves ChangedFiles empty, the graphql history cannot list them and is already restricted to the path
+func (ghe *GitHubExtractor) extractHistory(owner, repo, path string) []model.Commit {
+	history, err := ghe.Client.GraphQL.FetchCommitHistory(context.TODO(), owner, repo, path)
+	if err != nil {
+		logging.SugaredLogger.Debugf("could not extract commit history of '%s', falling back to rest : %s", ghe.RepositoryURL, err)
+		return ghe.listCommits(owner, repo, path)
+	}
+
+	var result []model.Commit
+
+	for _, c := range history {
+
+		commit := model.Commit{
+			SHA:        c.Oid,
+			Author:     c.Author.Login(),
+			AuthorName: c.Author.Name,
+			Committer:  c.Committer.Login(),
+			Message:    c.Message,
+			Timestamp:  c.CommittedDate,
+			Additions:  c.Additions,
+			Deletions:  c.Deletions,
+			Total:      c.Additions + c.Deletions,
+			Bot:        c.Author.IsBot(),
+		}
+
+		result = append(result, commit)
+	}
+
+	return result
+}
+
+func (ghe *GitHubExtractor) listCommits(owner, repo, path string) []model.Commit {
+	commits, err := ghe.Client.Repositories.ListCommits(context.TODO(), owner, repo, &github.CommitsListOptions{Path: path})
 	if err != nil {
 		logging.SugaredLogger.Debugf("could not extract commits of '%s' : %s", ghe.RepositoryURL, err)
 		return nil
@@ -301,7 +572,9 @@ func (ghe *GitHubExtractor) extractCommits(owner, repo string) []model.Commit {
 		}
 
 		commit := model.Commit{
+			SHA:          c.GetSHA(),
 			Author:       c.GetAuthor().GetLogin(),
+			AuthorName:   c.GetCommit().GetAuthor().GetName(),
 			Committer:    c.GetCommitter().GetLogin(),
 			ChangedFiles: changedFiles,
 			Message:      c.GetCommit().GetMessage(),
@@ -309,6 +582,7 @@ func (ghe *GitHubExtractor) extractCommits(owner, repo string) []model.Commit {
 			Additions:    c.GetCommit().GetStats().GetAdditions(),
 			Deletions:    c.GetCommit().GetStats().GetDeletions(),
 			Total:        c.GetCommit().GetStats().GetTotal(),
+			Bot:          isBot(c.GetAuthor()),
 		}
 
 		result = append(result, commit)
@@ -350,6 +624,7 @@ func (ghe *GitHubExtractor) extractContributors(owner, repo string) []model.Cont
 			Repositories:      info.Repositories.TotalCount,
 			FirstContribution: firstContribution,
 			LastContribution:  lastContribution,
+			Bot:               c.GetType() == "Bot",
 		}
 
 		result = append(result, contributor)
@@ -442,6 +717,8 @@ func (ghe *GitHubExtractor) extractOrganization(o string) *model.Organization {
 		TotalPrivateRepos: org.GetTotalPrivateRepos(),
 		OwnedPrivateRepos: org.GetOwnedPrivateRepos(),
 		Collaborators:     org.GetCollaborators(),
+		Verified:          org.GetIsVerified(),
+		Company:           org.GetCompany(),
 	}
 
 	return organization
@@ -1,9 +1,12 @@
 package extraction
 
 import (
+	"github.com/a-grasso/deprec/githubapi"
 	"github.com/a-grasso/deprec/model"
+	"github.com/google/go-github/v48/github"
 	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
 var testDependency = model.Dependency{
@@ -12,7 +15,7 @@ var testDependency = model.Dependency{
 	ExternalReferences: map[model.ExternalReference]string{"vcs": "https://github.com//.git"},
 }
 
-var ghe = NewGitHubExtractor(testDependency, config.GitHub, cacheClient)
+var ghe, _ = NewGitHubExtractor(testDependency, config.GitHub, cacheClient)
 
 func TestExtractOrganizationNil(t *testing.T) {
 	t.Cleanup(CleanDatabase)
@@ -102,3 +105,53 @@ func TestExtractTagsNil(t *testing.T) {
 
 	CheckNoDatabase(t)
 }
+
+func TestDetectMove(t *testing.T) {
+
+	tests := []struct {
+		owner, repo string
+		fullName    string
+		movedTo     string
+	}{
+		{"square", "okhttp", "square/okhttp", ""},
+		{"Square", "OkHttp", "square/okhttp", ""},
+		{"request", "request", "request/request-legacy", "request/request-legacy"},
+		{"old-owner", "repo", "new-owner/repo", "new-owner/repo"},
+		{"owner", "repo", "", ""},
+	}
+
+	for _, test := range tests {
+		repository := &github.Repository{FullName: &test.fullName}
+		assert.Equal(t, test.movedTo, detectMove(test.owner, test.repo, repository), test.owner+"/"+test.repo)
+	}
+}
+
+func TestCollectIssueComments(t *testing.T) {
+
+	comment := func(login, typename, association string, day int) githubapi.IssueComment {
+		var c githubapi.IssueComment
+		c.Author.Login = login
+		c.Author.Typename = typename
+		c.AuthorAssociation = association
+		c.CreatedAt = time.Date(2022, 1, day, 0, 0, 0, 0, time.UTC)
+		return c
+	}
+
+	issue := model.Issue{Author: "reporter"}
+
+	collectIssueComments(&issue, []githubapi.IssueComment{
+		comment("renovate", "Bot", "NONE", 1),
+		comment("reporter", "User", "NONE", 2),
+		comment("user", "User", "NONE", 3),
+		comment("maintainer", "User", "COLLABORATOR", 4),
+		comment("user", "User", "NONE", 5),
+	})
+
+	assert.Len(t, issue.Contributions, 5)
+	assert.True(t, issue.Contributions[0].Bot)
+	assert.Equal(t, "COLLABORATOR", issue.Contributions[3].AuthorAssociation)
+	assert.Equal(t, []string{"renovate", "reporter", "user", "maintainer"}, issue.Contributors)
+
+	assert.Equal(t, time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC), *issue.FirstResponse)
+	assert.Equal(t, time.Date(2022, 1, 4, 0, 0, 0, 0, time.UTC), *issue.FirstMaintainerResponse)
+}
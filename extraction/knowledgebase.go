@@ -0,0 +1,35 @@
+package extraction
+
+import (
+	"errors"
+	"github.com/a-grasso/deprec/knowledgebase"
+	"github.com/a-grasso/deprec/logging"
+	"github.com/a-grasso/deprec/model"
+	"time"
+)
+
+type KnowledgeBaseExtractor struct {
+	Dependency    model.Dependency
+	KnowledgeBase *knowledgebase.KnowledgeBase
+}
+
+// NewKnowledgeBaseExtractor takes the knowledge base loaded once per run, see knowledgebase.Load
+func NewKnowledgeBaseExtractor(dependency model.Dependency, kb *knowledgebase.KnowledgeBase) (*KnowledgeBaseExtractor, error) {
+
+	if kb == nil {
+		return nil, errors.New("no knowledge base loaded")
+	}
+
+	return &KnowledgeBaseExtractor{
+		Dependency:    dependency,
+		KnowledgeBase: kb,
+	}, nil
+}
+
+func (kbe *KnowledgeBaseExtractor) Extract(dataModel *model.DataModel) error {
+	logging.SugaredLogger.Infof("looking up '%s' in knowledge base", kbe.Dependency.Name)
+
+	dataModel.Knowledge = kbe.KnowledgeBase.Lookup(kbe.Dependency, time.Now())
+
+	return nil
+}
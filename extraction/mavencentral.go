@@ -3,71 +3,132 @@ package extraction
 import (
 	"fmt"
 	"github.com/a-grasso/deprec/cache"
+	"github.com/a-grasso/deprec/configuration"
 	"github.com/a-grasso/deprec/logging"
 	"github.com/a-grasso/deprec/mavencentralapi"
 	"github.com/a-grasso/deprec/model"
 	"github.com/thoas/go-funk"
 	"github.com/vifraa/gopom"
+	"strings"
 	"time"
 )
 
 type MavenCentralExtractor struct {
 	DependencyName string
-	SHA1           string
+	PackageURL     *model.PackageURL
+	Hashes         map[model.HashAlgorithm]string
 	Client         *mavencentralapi.ClientWrapper
 }
 
-func NewMavenCentralExtractor(dependency model.Dependency, cache *cache.Cache) *MavenCentralExtractor {
+func NewMavenCentralExtractor(dependency model.Dependency, config configuration.MavenCentral, cache *cache.Cache) *MavenCentralExtractor {
 
-	client := mavencentralapi.NewClient()
+	client := mavencentralapi.NewClient(config)
 
 	wrapper := mavencentralapi.NewClientWrapper(client, cache)
 
-	sha1 := dependency.Hashes[model.SHA1]
+	purl, err := model.ParsePackageURL(dependency.PackageURL)
+	if err != nil {
+		logging.SugaredLogger.Debugf("could not parse package url of '%s' : %s", dependency.Name, err)
+		purl = nil
+	}
 
 	return &MavenCentralExtractor{
 		DependencyName: dependency.Name,
-		SHA1:           sha1,
+		PackageURL:     purl,
+		Hashes:         dependency.Hashes,
 		Client:         wrapper,
 	}
 }
 
-func (mce *MavenCentralExtractor) Extract(dataModel *model.DataModel) {
-	logging.SugaredLogger.Infof("extracting maven central '%s' with SHA-1 '%s'", mce.DependencyName, mce.SHA1)
+func (mce *MavenCentralExtractor) Extract(dataModel *model.DataModel) error {
+	logging.SugaredLogger.Infof("extracting maven central '%s'", mce.DependencyName)
 
-	search, err := mce.Client.SearchMavenCentralSHA1(mce.SHA1)
-
-	if err != nil {
-		logging.SugaredLogger.Debugf("could not search maven central '%s' with SHA-1 '%s'", mce.DependencyName, mce.SHA1)
-		return
+	groupId, artifactId, version, timestamp, found := mce.resolveCoordinates()
+	if !found {
+		return fmt.Errorf("could not resolve maven coordinates of '%s'", mce.DependencyName)
 	}
 
-	if len(search.Response.Docs) == 0 {
-		return
-	}
-
-	response := search.Response.Docs[0]
-
-	version := response.V
-	artifactId := response.A
-	groupId := response.G
-	var msToNs int64 = 1000000
-	timestamp := time.Unix(0, response.Timestamp*msToNs)
-
 	library := mce.extractLibrary(groupId, artifactId)
 
+	if timestamp.IsZero() && library != nil {
+		timestamp = publicationDate(library.Releases, version)
+	}
+
 	artifact := mce.extractArtifact(groupId, artifactId, version, timestamp)
 
 	dataModel.Distribution = &model.Distribution{
 		Library:  library,
 		Artifact: artifact,
 	}
+
+	return nil
+}
+
+var hashPriority = []model.HashAlgorithm{model.SHA1, model.SHA256, model.MD5}
+
+func (mce *MavenCentralExtractor) resolveCoordinates() (groupId, artifactId, version string, timestamp time.Time, found bool) {
+
+	if purl := mce.PackageURL; purl != nil && purl.Type == "maven" && purl.Namespace != "" && purl.Version != "" {
+
+		// the publication date stays unknown if the search fails, see publicationDate
+		search, err := mce.Client.SearchGAV(purl.Namespace, purl.Name, purl.Version)
+		if err != nil {
+			logging.SugaredLogger.Debugf("could not search maven central '%s' by coordinates : %s", mce.DependencyName, err)
+		} else if len(search.Response.Docs) != 0 {
+			timestamp = toTime(search.Response.Docs[0].Timestamp)
+		}
+
+		return purl.Namespace, purl.Name, purl.Version, timestamp, true
+	}
+
+	for _, algorithm := range hashPriority {
+
+		hash := mce.Hashes[algorithm]
+		if hash == "" || !mce.Client.SupportsHash(algorithm) {
+			continue
+		}
+
+		search, err := mce.Client.SearchHash(algorithm, hash)
+		if err != nil {
+			logging.SugaredLogger.Debugf("could not search maven central '%s' with %s '%s' : %s", mce.DependencyName, algorithm, hash, err)
+			continue
+		}
+
+		if len(search.Response.Docs) == 0 {
+			continue
+		}
+
+		response := search.Response.Docs[0]
+
+		return response.G, response.A, response.V, toTime(response.Timestamp), true
+	}
+
+	return "", "", "", time.Time{}, false
+}
+
+// publicationDate is the zero time if the version is not among the releases
+func publicationDate(releases []model.Release, version string) time.Time {
+	for _, release := range releases {
+		if release.Version == version {
+			return release.Date
+		}
+	}
+	return time.Time{}
+}
+
+func toTime(milliseconds int64) time.Time {
+	if milliseconds == 0 {
+		return time.Time{}
+	}
+
+	var msToNs int64 = 1000000
+	return time.Unix(0, milliseconds*msToNs)
 }
 
 func (mce *MavenCentralExtractor) extractArtifact(groupId string, artifactId string, version string, date time.Time) *model.Artifact {
 	pom, err := mce.Client.GetArtifactPom(groupId, artifactId, version)
 	if err != nil {
-		logging.SugaredLogger.Debugf("could not get artifact pom for '%s' with SHA-1 '%s'", mce.DependencyName, mce.SHA1)
+		logging.SugaredLogger.Debugf("could not get artifact pom for '%s' : %s", mce.DependencyName, err)
 		return nil
 	}
 
@@ -79,10 +140,22 @@ func (mce *MavenCentralExtractor) extractArtifact(groupId string, artifactId str
 
 	developers := funk.Map(pom.Developers, func(c gopom.Developer) string { return c.Email }).([]string)
 
-	licenses := funk.Map(pom.Licenses, func(l gopom.License) string { return l.Name }).([]string)
-
 	mailingLists := funk.Map(pom.MailingLists, func(ml gopom.MailingList) string { return ml.Name }).([]string)
 
+	relocation := collectRelocation(groupId, artifactId, pom.DistributionManagement.Relocation)
+
+	lineage := append([]*gopom.Project{pom}, mce.resolveParents(pom)...)
+
+	parents := funk.Map(lineage[1:], func(p *gopom.Project) string {
+		return fmt.Sprintf("%s:%s:%s", p.GroupID, p.ArtifactID, p.Version)
+	}).([]string)
+
+	licenses := effectiveLicenses(lineage)
+
+	organization := effectiveOrganization(lineage)
+
+	scm := effectiveSCM(lineage)
+
 	return &model.Artifact{
 		Version:              version,
 		ArtifactRepositories: repos,
@@ -90,14 +163,130 @@ func (mce *MavenCentralExtractor) extractArtifact(groupId string, artifactId str
 		Vulnerabilities:      nil,
 		Dependents:           nil,
 		Dependencies:         dependencies,
-		DeprecationWarning:   false,
+		DeprecationWarning:   relocation != nil,
+		DeprecationMessage:   pom.DistributionManagement.Relocation.Message,
+		Relocation:           relocation,
 		Contributors:         contributors,
 		Developers:           developers,
-		Organization:         pom.Organization.Name,
+		Organization:         organization,
 		Licenses:             licenses,
 		MailingLists:         mailingLists,
 		Description:          pom.Description,
+		SCM:                  scm,
+		Parents:              parents,
+	}
+}
+
+const maxParentDepth = 10
+
+func (mce *MavenCentralExtractor) resolveParents(pom *gopom.Project) []*gopom.Project {
+
+	var parents []*gopom.Project
+
+	visited := make(map[gopom.Parent]bool)
+
+	parent := pom.Parent
+	for len(parents) < maxParentDepth && parent.ArtifactID != "" && !visited[parent] {
+
+		visited[parent] = true
+
+		parentPom, err := mce.Client.GetArtifactPom(parent.GroupID, parent.ArtifactID, parent.Version)
+		if err != nil {
+			logging.SugaredLogger.Debugf("could not get parent pom '%s:%s:%s' of '%s' : %s", parent.GroupID, parent.ArtifactID, parent.Version, mce.DependencyName, err)
+			break
+		}
+
+		// parents may inherit their coordinates as well
+		if parentPom.GroupID == "" {
+			parentPom.GroupID = parent.GroupID
+		}
+		if parentPom.Version == "" {
+			parentPom.Version = parent.Version
+		}
+
+		parents = append(parents, parentPom)
+
+		parent = parentPom.Parent
+	}
+
+	return parents
+}
+
+func effectiveLicenses(lineage []*gopom.Project) []string {
+	for _, pom := range lineage {
+		if len(pom.Licenses) != 0 {
+			return funk.Map(pom.Licenses, func(l gopom.License) string { return l.Name }).([]string)
+		}
+	}
+	return nil
+}
+
+func effectiveOrganization(lineage []*gopom.Project) string {
+	for _, pom := range lineage {
+		if pom.Organization.Name != "" {
+			return pom.Organization.Name
+		}
+	}
+	return ""
+}
+
+// effectiveSCM inherits every scm field on its own like maven does, url and connections inherited from a parent get the
+// artifactId of every inheriting child appended
+func effectiveSCM(lineage []*gopom.Project) *model.SCM {
+
+	inherit := func(field func(gopom.Scm) string, appendPath bool) string {
+
+		var path []string
+
+		for _, pom := range lineage {
+			if value := field(pom.SCM); value != "" {
+				if appendPath && len(path) != 0 {
+					value = strings.TrimSuffix(value, "/") + "/" + strings.Join(path, "/")
+				}
+				return value
+			}
+			path = append([]string{pom.ArtifactID}, path...)
+		}
+
+		return ""
+	}
+
+	result := &model.SCM{
+		URL:                 inherit(func(scm gopom.Scm) string { return scm.URL }, true),
+		Connection:          inherit(func(scm gopom.Scm) string { return scm.Connection }, true),
+		DeveloperConnection: inherit(func(scm gopom.Scm) string { return scm.DeveloperConnection }, true),
+		Tag:                 inherit(func(scm gopom.Scm) string { return scm.Tag }, false),
 	}
+
+	if *result == (model.SCM{}) {
+		return nil
+	}
+
+	return result
+}
+
+func collectRelocation(groupId, artifactId string, relocation gopom.Relocation) *model.Relocation {
+
+	if relocation == (gopom.Relocation{}) {
+		return nil
+	}
+
+	result := &model.Relocation{
+		GroupID:    relocation.GroupID,
+		ArtifactID: relocation.ArtifactID,
+		Version:    relocation.Version,
+		Message:    relocation.Message,
+	}
+
+	// omitted coordinates default to the ones of the relocated artifact
+	if result.GroupID == "" {
+		result.GroupID = groupId
+	}
+	if result.ArtifactID == "" {
+		result.ArtifactID = artifactId
+	}
+
+	return result
 }
 
 func collectDependencies(pom *gopom.Project) []string {
@@ -138,7 +327,7 @@ func collectDependencies(pom *gopom.Project) []string {
 func (mce *MavenCentralExtractor) extractLibrary(groupId string, artifactId string) *model.Library {
 	metadata, err := mce.Client.GetLibraryMetadata(groupId, artifactId)
 	if err != nil {
-		logging.SugaredLogger.Debugf("could not get library metadata for '%s' with SHA-1 '%s'", mce.DependencyName, mce.SHA1)
+		logging.SugaredLogger.Debugf("could not get library metadata for '%s' : %s", mce.DependencyName, err)
 		return nil
 	}
 
@@ -147,13 +336,34 @@ func (mce *MavenCentralExtractor) extractLibrary(groupId string, artifactId stri
 		lastUpdated = time.Time{}
 	}
 
+	releases := mce.extractReleases(groupId, artifactId)
+
 	return &model.Library{
 		Ranking:       nil,
 		Licenses:      nil,
 		UsedBy:        nil,
 		Versions:      metadata.Versioning.Versions.Version,
+		Releases:      releases,
 		LastUpdated:   lastUpdated,
 		LatestVersion: metadata.Versioning.Latest,
 		LatestRelease: metadata.Versioning.Release,
 	}
 }
+
+func (mce *MavenCentralExtractor) extractReleases(groupId string, artifactId string) []model.Release {
+	search, err := mce.Client.SearchVersions(groupId, artifactId)
+	if err != nil {
+		logging.SugaredLogger.Debugf("could not search versions of '%s' : %s", mce.DependencyName, err)
+		return nil
+	}
+
+	var releases []model.Release
+	for _, doc := range search.Response.Docs {
+		releases = append(releases, model.Release{
+			Version: doc.V,
+			Date:    toTime(doc.Timestamp),
+		})
+	}
+
+	return releases
+}
@@ -0,0 +1,88 @@
+package extraction
+
+import (
+	"github.com/a-grasso/deprec/cache"
+	"github.com/a-grasso/deprec/configuration"
+	"github.com/a-grasso/deprec/mavencentralapi"
+	"github.com/a-grasso/deprec/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/vifraa/gopom"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+var parentPoms = map[string]string{
+	"/org/example/mid/1.0/mid-1.0.pom": `<project>
+  <artifactId>mid</artifactId>
+  <parent><groupId>org.example</groupId><artifactId>root</artifactId><version>1.0</version></parent>
+</project>`,
+	"/org/example/root/1.0/root-1.0.pom": `<project>
+  <groupId>org.example</groupId>
+  <artifactId>root</artifactId>
+  <version>1.0</version>
+  <scm>
+    <url>https://github.com/o/r/</url>
+    <connection>scm:git:https://github.com/o/r.git</connection>
+    <developerConnection>scm:git:ssh://git@github.com/o/r.git</developerConnection>
+    <tag>HEAD</tag>
+  </scm>
+</project>`,
+	"/org/example/loop/1.0/loop-1.0.pom": `<project>
+  <artifactId>loop</artifactId>
+  <parent><groupId>org.example</groupId><artifactId>loop</artifactId><version>1.0</version></parent>
+</project>`,
+}
+
+func parentPomExtractor(t *testing.T) *MavenCentralExtractor {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pom, exists := parentPoms[r.URL.Path]
+		if !exists {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(pom))
+	}))
+	t.Cleanup(server.Close)
+
+	client := mavencentralapi.NewClient(configuration.MavenCentral{RepositoryURL: server.URL})
+
+	return &MavenCentralExtractor{DependencyName: "core", Client: mavencentralapi.NewClientWrapper(client, &cache.Cache{})}
+}
+
+func TestResolveParentsInheritsSCMFieldByField(t *testing.T) {
+
+	extractor := parentPomExtractor(t)
+
+	child := &gopom.Project{
+		ArtifactID: "core",
+		Parent:     gopom.Parent{GroupID: "org.example", ArtifactID: "mid", Version: "1.0"},
+		SCM:        gopom.Scm{Tag: "core-1.0"},
+	}
+
+	parents := extractor.resolveParents(child)
+
+	assert.Len(t, parents, 2)
+	assert.Equal(t, "org.example", parents[0].GroupID)
+	assert.Equal(t, "1.0", parents[0].Version)
+
+	assert.Equal(t, &model.SCM{
+		URL:                 "https://github.com/o/r/mid/core",
+		Connection:          "scm:git:https://github.com/o/r.git/mid/core",
+		DeveloperConnection: "scm:git:ssh://git@github.com/o/r.git/mid/core",
+		Tag:                 "core-1.0",
+	}, effectiveSCM(append([]*gopom.Project{child}, parents...)))
+}
+
+func TestResolveParentsStopsAtCycles(t *testing.T) {
+
+	extractor := parentPomExtractor(t)
+
+	child := &gopom.Project{ArtifactID: "core", Parent: gopom.Parent{GroupID: "org.example", ArtifactID: "loop", Version: "1.0"}}
+
+	parents := extractor.resolveParents(child)
+
+	assert.Len(t, parents, 1)
+	assert.Nil(t, effectiveSCM(append([]*gopom.Project{child}, parents...)))
+}
@@ -0,0 +1,146 @@
+package extraction
+
+import (
+	"github.com/a-grasso/deprec/logging"
+	"github.com/a-grasso/deprec/model"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// subpathFromSCM takes the module directory from the scm url of the artifact, as long as it points into the same repository
+func (ghe *GitHubExtractor) subpathFromSCM(dataModel *model.DataModel) string {
+
+	if dataModel.Distribution == nil || dataModel.Distribution.Artifact == nil || dataModel.Distribution.Artifact.SCM == nil {
+		return ""
+	}
+
+	url := dataModel.Distribution.Artifact.SCM.URL
+	if !strings.Contains(url, "github.com/") {
+		return ""
+	}
+
+	owner, repo := parseVCSString(url)
+	if !strings.EqualFold(owner, ghe.Owner) || !strings.EqualFold(repo, ghe.Repository) {
+		return ""
+	}
+
+	subpath := parseSubpath(url)
+	if subpath != "" {
+		logging.SugaredLogger.Infof("scoping '%s/%s' to '%s' as declared by the scm of the artifact", ghe.Owner, ghe.Repository, subpath)
+	}
+
+	return subpath
+}
+
+// scopeReleases keeps releases tagged for the module, like 'module-v1.2.3' or '@scope/module@1.2.3',
+// repositories versioning all of their modules together have no such tags and keep all releases
+func scopeReleases(releases []model.Release, modules []string) []model.Release {
+
+	var result []model.Release
+
+	for _, release := range releases {
+		tag := release.Tag
+		if tag == "" {
+			tag = release.Version
+		}
+
+		for _, module := range modules {
+			if isModuleTag(tag, module) {
+				result = append(result, release)
+				break
+			}
+		}
+	}
+
+	if len(result) == 0 {
+		return releases
+	}
+
+	return result
+}
+
+func isModuleTag(tag, module string) bool {
+
+	if module == "" {
+		return false
+	}
+
+	tag = strings.ToLower(tag)
+	module = strings.ToLower(module)
+
+	// lerna style tags carry the full package name including its scope
+	if i := strings.LastIndex(tag, "@"); i > 0 {
+		tag = tag[:i] + "-" + tag[i+1:]
+	}
+	if i := strings.LastIndex(tag, "/"); i >= 0 {
+		tag = tag[i+1:]
+	}
+
+	if !strings.HasPrefix(tag, module) {
+		return false
+	}
+
+	rest := tag[len(module):]
+	if rest == "" || !strings.ContainsRune("-_v", rune(rest[0])) {
+		return false
+	}
+
+	rest = strings.TrimPrefix(strings.TrimLeft(rest, "-_"), "v")
+
+	return rest != "" && unicode.IsDigit(rune(rest[0]))
+}
+
+// scopeContributors keeps contributors with commits in the scoped history and recounts their contributions from it
+func scopeContributors(contributors []model.Contributor, commits []model.Commit) []model.Contributor {
+
+	type activity struct {
+		count       int
+		first, last time.Time
+	}
+
+	activities := make(map[string]*activity)
+	for _, commit := range commits {
+		if commit.Author == "" {
+			continue
+		}
+
+		a, exists := activities[commit.Author]
+		if !exists {
+			a = &activity{first: commit.Timestamp, last: commit.Timestamp}
+			activities[commit.Author] = a
+		}
+
+		a.count++
+		if commit.Timestamp.Before(a.first) {
+			a.first = commit.Timestamp
+		}
+		if commit.Timestamp.After(a.last) {
+			a.last = commit.Timestamp
+		}
+	}
+
+	var result []model.Contributor
+	for _, contributor := range contributors {
+		a, exists := activities[contributor.Name]
+		if !exists {
+			continue
+		}
+
+		first, last := a.first, a.last
+
+		contributor.Contributions = a.count
+		contributor.FirstContribution = &first
+		contributor.LastContribution = &last
+
+		result = append(result, contributor)
+	}
+
+	// the core team is derived from contributors ordered by contributions, like github lists them
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Contributions > result[j].Contributions
+	})
+
+	return result
+}
@@ -0,0 +1,123 @@
+package extraction
+
+import (
+	"github.com/a-grasso/deprec/model"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestIsModuleTag(t *testing.T) {
+
+	tests := []struct {
+		tag    string
+		module string
+		match  bool
+	}{
+		{"core-v1.2.3", "core", true},
+		{"core_1.2.3", "core", true},
+		{"Core-1.2.3", "core", true},
+		{"@scope/core@1.2.3", "core", true},
+		{"core/v1.2.3", "core", false},
+		{"modules/core/v1.2.3", "core", false},
+		{"core-utils-v1.2.3", "core", false},
+		{"corev1.2.3", "core", true},
+		{"core-latest", "core", false},
+		{"v1.2.3", "core", false},
+		{"core", "core", false},
+		{"v1.2.3", "", false},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.match, isModuleTag(test.tag, test.module), test.tag)
+	}
+}
+
+func TestScopeReleases(t *testing.T) {
+
+	releases := []model.Release{
+		{Tag: "core-v1.0.0", Version: "1.0.0"},
+		{Tag: "web-v2.0.0", Version: "2.0.0"},
+		{Version: "@scope/core@1.1.0"},
+	}
+
+	tests := []struct {
+		modules  []string
+		versions []string
+	}{
+		{[]string{"core"}, []string{"1.0.0", "@scope/core@1.1.0"}},
+		{[]string{"@scope/core", "web"}, []string{"2.0.0"}},
+		{[]string{"other"}, []string{"1.0.0", "2.0.0", "@scope/core@1.1.0"}},
+		{nil, []string{"1.0.0", "2.0.0", "@scope/core@1.1.0"}},
+	}
+
+	for _, test := range tests {
+		var versions []string
+		for _, release := range scopeReleases(releases, test.modules) {
+			versions = append(versions, release.Version)
+		}
+		assert.Equal(t, test.versions, versions, test.modules)
+	}
+}
+
+func TestScopeContributors(t *testing.T) {
+
+	day := func(d int) time.Time { return time.Date(2023, 1, d, 0, 0, 0, 0, time.UTC) }
+
+	contributors := []model.Contributor{
+		{Name: "alice", Contributions: 100},
+		{Name: "bob", Contributions: 50},
+		{Name: "carol", Contributions: 10},
+	}
+
+	commits := []model.Commit{
+		{Author: "carol", Timestamp: day(3)},
+		{Author: "bob", Timestamp: day(5)},
+		{Author: "carol", Timestamp: day(1)},
+		{Author: "", Timestamp: day(2)},
+		{Author: "carol", Timestamp: day(9)},
+	}
+
+	scoped := scopeContributors(contributors, commits)
+
+	tests := []struct {
+		name          string
+		contributions int
+		first, last   time.Time
+	}{
+		{"carol", 3, day(1), day(9)},
+		{"bob", 1, day(5), day(5)},
+	}
+
+	assert.Len(t, scoped, len(tests))
+
+	for i, test := range tests {
+		assert.Equal(t, test.name, scoped[i].Name)
+		assert.Equal(t, test.contributions, scoped[i].Contributions, test.name)
+		assert.Equal(t, test.first, *scoped[i].FirstContribution, test.name)
+		assert.Equal(t, test.last, *scoped[i].LastContribution, test.name)
+	}
+
+	assert.Empty(t, scopeContributors(contributors, nil))
+}
+
+func TestParseSubpath(t *testing.T) {
+
+	tests := []struct {
+		url     string
+		subpath string
+	}{
+		{"https://github.com/o/r", ""},
+		{"https://github.com/o/r/", ""},
+		{"https://github.com/o/r/tree/main/modules/core", "modules/core"},
+		{"https://github.com/o/r/blob/main/modules/core/", "modules/core"},
+		{"https://github.com/o/r/tree/main", ""},
+		{"https://github.com/o/r/modules/core", "modules/core"},
+		{"scm:git:git://github.com/o/r.git/core", "core"},
+		{"https://gitlab.com/o/r/core", ""},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.subpath, parseSubpath(test.url), test.url)
+	}
+}
@@ -0,0 +1,118 @@
+package extraction
+
+import (
+	"fmt"
+	"github.com/a-grasso/deprec/cache"
+	"github.com/a-grasso/deprec/logging"
+	"github.com/a-grasso/deprec/model"
+	"github.com/a-grasso/deprec/npmregistryapi"
+	"github.com/thoas/go-funk"
+)
+
+type NPMExtractor struct {
+	PackageName string
+	Version     string
+	Client      *npmregistryapi.ClientWrapper
+}
+
+func NewNPMExtractor(dependency model.Dependency, cache *cache.Cache) (*NPMExtractor, error) {
+
+	purl, err := model.ParsePackageURL(dependency.PackageURL)
+	if err != nil {
+		return nil, err
+	}
+
+	client := npmregistryapi.NewClient()
+
+	wrapper := npmregistryapi.NewClientWrapper(client, cache)
+
+	version := purl.Version
+	if version == "" {
+		version = dependency.Version
+	}
+
+	return &NPMExtractor{
+		PackageName: purl.FullName(),
+		Version:     version,
+		Client:      wrapper,
+	}, nil
+}
+
+func (npme *NPMExtractor) Extract(dataModel *model.DataModel) error {
+	logging.SugaredLogger.Infof("extracting npm registry '%s@%s'", npme.PackageName, npme.Version)
+
+	p, err := npme.Client.GetPackage(npme.PackageName)
+	if err != nil {
+		return fmt.Errorf("could not get npm package '%s' : %w", npme.PackageName, err)
+	}
+
+	dataModel.Distribution = &model.Distribution{
+		Library:  npme.extractLibrary(p),
+		Artifact: npme.extractArtifact(p),
+	}
+
+	return nil
+}
+
+func (npme *NPMExtractor) extractLibrary(p *npmregistryapi.Package) *model.Library {
+
+	versions := funk.Map(p.Versions, func(v npmregistryapi.Version) string { return v.Version }).([]string)
+
+	releases := funk.Map(p.Versions, func(v npmregistryapi.Version) model.Release {
+		return model.Release{Version: v.Version, Date: v.Published}
+	}).([]model.Release)
+
+	var licenses []string
+	for _, v := range p.Versions {
+		if v.Version == p.Latest && v.License != "" {
+			licenses = append(licenses, v.License)
+		}
+	}
+
+	return &model.Library{
+		Ranking:       nil,
+		Licenses:      licenses,
+		UsedBy:        nil,
+		Versions:      versions,
+		Releases:      releases,
+		LastUpdated:   p.Modified,
+		LatestVersion: p.Latest,
+		LatestRelease: p.Latest,
+	}
+}
+
+func (npme *NPMExtractor) extractArtifact(p *npmregistryapi.Package) *model.Artifact {
+
+	var version *npmregistryapi.Version
+	for i, v := range p.Versions {
+		if v.Version == npme.Version {
+			version = &p.Versions[i]
+			break
+		}
+	}
+
+	if version == nil {
+		logging.SugaredLogger.Debugf("could not find version '%s' of npm package '%s'", npme.Version, npme.PackageName)
+		return nil
+	}
+
+	var licenses []string
+	if version.License != "" {
+		licenses = append(licenses, version.License)
+	}
+
+	description := version.Description
+	if description == "" {
+		description = p.Description
+	}
+
+	return &model.Artifact{
+		Version:            version.Version,
+		Description:        description,
+		Date:               version.Published,
+		DeprecationWarning: version.Deprecated != "",
+		DeprecationMessage: version.Deprecated,
+		FundingLinks:       version.Funding,
+		Licenses:           licenses,
+	}
+}
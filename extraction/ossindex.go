@@ -1,6 +1,7 @@
 package extraction
 
 import (
+	"fmt"
 	"github.com/a-grasso/deprec/cache"
 	"github.com/a-grasso/deprec/configuration"
 	"github.com/a-grasso/deprec/logging"
@@ -34,7 +35,8 @@ func NewOSSIndexExtractor(dependency model.Dependency, config configuration.OSSI
 	}, nil
 }
 
-func (ossie *OSSIndexExtractor) Extract(dataModel *model.DataModel) {
+// Extract leaves the vulnerability index empty for components oss index does not know
+func (ossie *OSSIndexExtractor) Extract(dataModel *model.DataModel) error {
 	logging.SugaredLogger.Infof("extracting ossindex '%s'", ossie.PackageURL)
 
 	index := &model.VulnerabilityIndex{}
@@ -43,23 +45,33 @@ func (ossie *OSSIndexExtractor) Extract(dataModel *model.DataModel) {
 
 	reports, err := ossie.Client.GetComponentReport(purl)
 	if err != nil {
-		return
+		return fmt.Errorf("could not get component report of '%s' : %w", purl, err)
 	}
 	if len(reports) != 1 {
-		return
+		return fmt.Errorf("expected one component report of '%s', got %d", purl, len(reports))
 	}
 
 	componentReport := reports[0]
 
 	resp, err := http.Get(componentReport.Reference)
 	if err != nil {
-		return
+		return fmt.Errorf("could not check component reference of '%s' : %w", purl, err)
 	}
+	defer resp.Body.Close()
+
 	if resp.StatusCode == http.StatusNotFound {
-		return
+		return nil
 	}
 
 	index.TotalVulnerabilitiesCount = len(componentReport.Vulnerabilities)
 
+	for _, vulnerability := range componentReport.Vulnerabilities {
+		if vulnerability.CVE != "" {
+			index.CVEs = append(index.CVEs, vulnerability.CVE)
+		}
+	}
+
 	dataModel.VulnerabilityIndex = index
+
+	return nil
 }
@@ -0,0 +1,67 @@
+package extraction
+
+import (
+	"fmt"
+	"github.com/a-grasso/deprec/cache"
+	"github.com/a-grasso/deprec/configuration"
+	"github.com/a-grasso/deprec/logging"
+	"github.com/a-grasso/deprec/model"
+	"github.com/a-grasso/deprec/scorecardapi"
+	"github.com/thoas/go-funk"
+	"time"
+)
+
+type ScorecardExtractor struct {
+	Owner      string
+	Repository string
+	Client     *scorecardapi.ClientWrapper
+}
+
+func NewScorecardExtractor(dependency model.Dependency, config configuration.Scorecard, cache *cache.Cache) *ScorecardExtractor {
+
+	client := scorecardapi.NewClient(config)
+
+	wrapper := scorecardapi.NewClientWrapper(client, cache)
+
+	owner, repo := parseVCSString(dependency.ExternalReferences[model.VCS])
+
+	return &ScorecardExtractor{
+		Owner:      owner,
+		Repository: repo,
+		Client:     wrapper,
+	}
+}
+
+func (sce *ScorecardExtractor) Extract(dataModel *model.DataModel) error {
+
+	// scorecards are computed for the canonical repository
+	if repository := dataModel.Repository; repository != nil && repository.RepositoryData != nil && repository.MovedTo != "" {
+		sce.Owner = repository.Owner
+		sce.Repository = repository.Name
+	}
+
+	logging.SugaredLogger.Infof("extracting scorecard of '%s/%s'", sce.Owner, sce.Repository)
+
+	result, err := sce.Client.GetResult("github.com", sce.Owner, sce.Repository)
+	if err != nil {
+		return fmt.Errorf("could not get scorecard of '%s/%s' : %w", sce.Owner, sce.Repository, err)
+	}
+
+	checks := funk.Map(result.Checks, func(c scorecardapi.Check) model.ScorecardCheck {
+		return model.ScorecardCheck{Name: c.Name, Score: c.Score, Reason: c.Reason}
+	}).([]model.ScorecardCheck)
+
+	date, _ := time.Parse("2006-01-02", result.Date)
+	if date.IsZero() {
+		date, _ = time.Parse(time.RFC3339, result.Date)
+	}
+
+	dataModel.Scorecard = &model.Scorecard{
+		Date:   date,
+		Commit: result.Repo.Commit,
+		Score:  result.Score,
+		Checks: checks,
+	}
+
+	return nil
+}
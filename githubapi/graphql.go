@@ -2,14 +2,18 @@ package githubapi
 
 import (
 	"context"
+	"crypto/sha1"
 	"errors"
 	"fmt"
 	"github.com/a-grasso/deprec/cache"
 	"github.com/a-grasso/deprec/model"
 	"github.com/google/go-github/v48/github"
+	"github.com/shurcooL/githubv4"
 	"github.com/thoas/go-funk"
 	"reflect"
+	"sort"
 	"strings"
+	"time"
 )
 
 func BatchQuery[T any](ctx context.Context, client *Client, queries map[string]string, vars map[string]any) (map[string]T, error) {
@@ -78,3 +82,484 @@ func (ql *GraphQLWrapper) FetchContributorInfo(ctx context.Context, repo string,
 
 	return mapped, err
 }
+
+const issueBatchSize = 50
+
+type IssueComment struct {
+	Author struct {
+		Login    string
+		Typename string `graphql:"__typename"`
+	}
+	AuthorAssociation string
+	CreatedAt         time.Time
+}
+
+// IssueComments holds the total count of comments, but only the first ones which are enough to find the first responses
+type IssueComments struct {
+	Number   int
+	Comments struct {
+		TotalCount int
+		Nodes      []IssueComment
+	} `graphql:"comments(first: 100)"`
+}
+
+// IssueTimeline is resolved through the node id, which can belong to an issue or a pull request
+type IssueTimeline struct {
+	Issue       IssueComments `graphql:"... on Issue"`
+	PullRequest IssueComments `graphql:"... on PullRequest"`
+}
+
+func (it IssueTimeline) Timeline() IssueComments {
+	if it.Issue.Number != 0 {
+		return it.Issue
+	}
+	return it.PullRequest
+}
+
+// FetchIssueTimelines gets the first comments of many issues in batches instead of one rest call per issue
+func (ql *GraphQLWrapper) FetchIssueTimelines(ctx context.Context, owner, repo string, issues []*github.Issue) (map[int]IssueComments, error) {
+
+	result := make(map[int]IssueComments)
+
+	for start := 0; start < len(issues); start += issueBatchSize {
+
+		end := start + issueBatchSize
+		if end > len(issues) {
+			end = len(issues)
+		}
+
+		issueQueries := map[string]string{}
+		for _, issue := range issues[start:end] {
+			issueQueries[issue.GetNodeID()] = fmt.Sprintf("node(id:\"%s\")", issue.GetNodeID())
+		}
+
+		// keyed by the issues of the batch, positions shift whenever an issue is opened
+		coll := ql.Cache.Database("query_issue_timelines").Collection(fmt.Sprintf("%s-%s-%s", owner, repo, batchKey(issues[start:end])))
+
+		batchQuery := func() (map[string]IssueTimeline, error) {
+			return BatchQuery[IssueTimeline](ctx, ql.Client, issueQueries, map[string]any{})
+		}
+
+		timelines, err := cache.FetchBatchQuery[IssueTimeline](ctx, coll, batchQuery)
+		if err != nil {
+			return result, err
+		}
+
+		for _, timeline := range timelines {
+			comments := timeline.Timeline()
+			// nodes which are neither issues nor pull requests, e.g. deleted ones, have no number
+			if comments.Number == 0 {
+				continue
+			}
+			result[comments.Number] = comments
+		}
+	}
+
+	return result, nil
+}
+
+// batchKey identifies a batch by its node ids, hashed as collection names are limited in length. Open issues still collect
+// comments, their last update is part of the key so the batch is fetched again once one of them changed
+func batchKey(issues []*github.Issue) string {
+
+	var keys []string
+	for _, issue := range issues {
+		key := issue.GetNodeID()
+		if issue.GetState() == "open" {
+			key = fmt.Sprintf("%s@%d", key, issue.GetUpdatedAt().Unix())
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return fmt.Sprintf("%x", sha1.Sum([]byte(strings.Join(keys, ","))))
+}
+
+// the most recently created pull requests are enough to judge how they are handled today
+const maxPullRequestPages = 5
+
+type PullRequest struct {
+	Number int
+	State  string
+	Author struct {
+		Login    string
+		Typename string `graphql:"__typename"`
+	}
+	AuthorAssociation string
+	CreatedAt         time.Time
+	MergedAt          *time.Time
+	ClosedAt          *time.Time
+	Reviews           struct {
+		TotalCount int
+		Nodes      []struct {
+			SubmittedAt *time.Time
+		}
+	} `graphql:"reviews(first: 1)"`
+	Comments struct {
+		TotalCount int
+	}
+}
+
+type pullRequestsQuery struct {
+	Repository struct {
+		PullRequests struct {
+			Nodes    []PullRequest
+			PageInfo struct {
+				EndCursor   githubv4.String
+				HasNextPage bool
+			}
+		} `graphql:"pullRequests(first: 100, after: $cursor, orderBy: {field: CREATED_AT, direction: DESC})"`
+	} `graphql:"repository(owner: $owner, name: $name)"`
+}
+
+func (ql *GraphQLWrapper) FetchPullRequests(ctx context.Context, owner, repo string) ([]PullRequest, error) {
+
+	coll := ql.Cache.Database("query_pull_requests").Collection(fmt.Sprintf("%s-%s", owner, repo))
+
+	f := func() ([]PullRequest, error) {
+
+		var result []PullRequest
+
+		vars := map[string]any{
+			"owner":  githubv4.String(owner),
+			"name":   githubv4.String(repo),
+			"cursor": (*githubv4.String)(nil),
+		}
+
+		for page := 0; page < maxPullRequestPages; page++ {
+
+			var query pullRequestsQuery
+			if err := ql.Client.GraphQL().Query(ctx, &query, vars); err != nil {
+				return result, err
+			}
+
+			pullRequests := query.Repository.PullRequests
+			result = append(result, pullRequests.Nodes...)
+
+			if !pullRequests.PageInfo.HasNextPage {
+				break
+			}
+
+			vars["cursor"] = githubv4.NewString(pullRequests.PageInfo.EndCursor)
+		}
+
+		return result, nil
+	}
+
+	return cache.FetchMultiple[PullRequest](ctx, coll, f)
+}
+
+type oldestOpenPullRequestQuery struct {
+	Repository struct {
+		PullRequests struct {
+			Nodes []PullRequest
+		} `graphql:"pullRequests(states: OPEN, first: 1, orderBy: {field: CREATED_AT, direction: ASC})"`
+	} `graphql:"repository(owner: $owner, name: $name)"`
+}
+
+// FetchOldestOpenPullRequest returns nil if the repository has no open pull request
+func (ql *GraphQLWrapper) FetchOldestOpenPullRequest(ctx context.Context, owner, repo string) (*PullRequest, error) {
+
+	coll := ql.Cache.Database("query_oldest_open_pull_request").Collection(fmt.Sprintf("%s-%s", owner, repo))
+
+	f := func() (*PullRequest, error) {
+
+		vars := map[string]any{
+			"owner": githubv4.String(owner),
+			"name":  githubv4.String(repo),
+		}
+
+		var query oldestOpenPullRequestQuery
+		if err := ql.Client.GraphQL().Query(ctx, &query, vars); err != nil {
+			return nil, err
+		}
+
+		if nodes := query.Repository.PullRequests.Nodes; len(nodes) != 0 {
+			return &nodes[0], nil
+		}
+
+		return &PullRequest{}, nil
+	}
+
+	pullRequest, err := cache.FetchSingle[PullRequest](ctx, coll, f)
+	if err != nil || pullRequest == nil || pullRequest.Number == 0 {
+		return nil, err
+	}
+
+	return pullRequest, nil
+}
+
+type CommitActor struct {
+	Name string
+	User *struct {
+		Login string
+	}
+}
+
+func (ca CommitActor) Login() string {
+	if ca.User == nil {
+		return ""
+	}
+	return ca.User.Login
+}
+
+// IsBot tells apps apart from people, apps have no user account but sign their commits with a name like 'dependabot[bot]'
+func (ca CommitActor) IsBot() bool {
+	return ca.User == nil && strings.HasSuffix(ca.Name, "[bot]")
+}
+
+// HistoryCommit carries only what the cores look at, which keeps the pages of big repositories small.
+// The graphql api does not list the changed files of a commit, the history is restricted by path instead
+type HistoryCommit struct {
+	Oid           string
+	Message       string
+	CommittedDate time.Time
+	Additions     int
+	Deletions     int
+	Author        CommitActor
+	Committer     CommitActor
+}
+
+type commitHistoryQuery struct {
+	Repository struct {
+		DefaultBranchRef struct {
+			Target struct {
+				Commit struct {
+					History struct {
+						Nodes    []HistoryCommit
+						PageInfo struct {
+							EndCursor   githubv4.String
+							HasNextPage bool
+						}
+					} `graphql:"history(first: 100, after: $cursor, since: $since, path: $path)"`
+				} `graphql:"... on Commit"`
+			}
+		}
+	} `graphql:"repository(owner: $owner, name: $name)"`
+}
+
+// FetchCommitHistory pages through the default branch once and afterwards only asks for commits newer than the cached ones,
+// a path restricts the history to commits touching that directory
+func (ql *GraphQLWrapper) FetchCommitHistory(ctx context.Context, owner, repo, path string) ([]HistoryCommit, error) {
+
+	name := fmt.Sprintf("%s-%s", owner, repo)
+	if path != "" {
+		name = fmt.Sprintf("%s-%s", name, strings.ReplaceAll(path, "/", "-"))
+	}
+
+	coll := ql.Cache.Database("query_commit_history").Collection(name)
+
+	f := func(since *time.Time) ([]HistoryCommit, error) {
+
+		var result []HistoryCommit
+
+		vars := map[string]any{
+			"owner":  githubv4.String(owner),
+			"name":   githubv4.String(repo),
+			"cursor": (*githubv4.String)(nil),
+			"since":  (*githubv4.GitTimestamp)(nil),
+			"path":   (*githubv4.String)(nil),
+		}
+
+		if path != "" {
+			vars["path"] = githubv4.NewString(githubv4.String(path))
+		}
+
+		if since != nil {
+			vars["since"] = &githubv4.GitTimestamp{Time: *since}
+		}
+
+		for {
+			var query commitHistoryQuery
+			if err := ql.Client.GraphQL().Query(ctx, &query, vars); err != nil {
+				return nil, err
+			}
+
+			history := query.Repository.DefaultBranchRef.Target.Commit.History
+			result = append(result, history.Nodes...)
+
+			if !history.PageInfo.HasNextPage {
+				break
+			}
+
+			vars["cursor"] = githubv4.NewString(history.PageInfo.EndCursor)
+		}
+
+		return result, nil
+	}
+
+	timestamp := func(c HistoryCommit) time.Time { return c.CommittedDate }
+	key := func(c HistoryCommit) string { return c.Oid }
+
+	return cache.FetchIncremental[HistoryCommit](ctx, coll, f, timestamp, key)
+}
+
+type SecurityVulnerability struct {
+	Advisory struct {
+		GhsaId      string
+		Summary     string
+		PublishedAt time.Time
+		WithdrawnAt *time.Time
+		Identifiers []struct {
+			Type  string
+			Value string
+		}
+	}
+	Severity               string
+	VulnerableVersionRange string
+	FirstPatchedVersion    *struct {
+		Identifier string
+	}
+}
+
+type securityVulnerabilitiesQuery struct {
+	SecurityVulnerabilities struct {
+		Nodes    []SecurityVulnerability
+		PageInfo struct {
+			EndCursor   githubv4.String
+			HasNextPage bool
+		}
+	} `graphql:"securityVulnerabilities(first: 100, after: $cursor, ecosystem: $ecosystem, package: $package)"`
+}
+
+// FetchSecurityVulnerabilities lists the vulnerabilities the github advisory database knows for a package
+func (ql *GraphQLWrapper) FetchSecurityVulnerabilities(ctx context.Context, ecosystem githubv4.SecurityAdvisoryEcosystem, pkg string) ([]SecurityVulnerability, error) {
+
+	coll := ql.Cache.Database("query_security_vulnerabilities").Collection(fmt.Sprintf("%s-%s", ecosystem, pkg))
+
+	f := func() ([]SecurityVulnerability, error) {
+
+		var result []SecurityVulnerability
+
+		vars := map[string]any{
+			"ecosystem": ecosystem,
+			"package":   githubv4.String(pkg),
+			"cursor":    (*githubv4.String)(nil),
+		}
+
+		for {
+			var query securityVulnerabilitiesQuery
+			if err := ql.Client.GraphQL().Query(ctx, &query, vars); err != nil {
+				return nil, err
+			}
+
+			vulnerabilities := query.SecurityVulnerabilities
+			result = append(result, vulnerabilities.Nodes...)
+
+			if !vulnerabilities.PageInfo.HasNextPage {
+				break
+			}
+
+			vars["cursor"] = githubv4.NewString(vulnerabilities.PageInfo.EndCursor)
+		}
+
+		return result, nil
+	}
+
+	return cache.FetchMultiple[SecurityVulnerability](ctx, coll, f)
+}
+
+type SecurityPolicy struct {
+	IsSecurityPolicyEnabled bool
+	SecurityPolicyUrl       string
+}
+
+func (ql *GraphQLWrapper) FetchSecurityPolicy(ctx context.Context, owner, repo string) (*SecurityPolicy, error) {
+
+	coll := ql.Cache.Database("query_security_policy").Collection(fmt.Sprintf("%s-%s", owner, repo))
+
+	f := func() (*SecurityPolicy, error) {
+		var query struct {
+			Repository SecurityPolicy `graphql:"repository(owner: $owner, name: $name)"`
+		}
+
+		vars := map[string]any{
+			"owner": githubv4.String(owner),
+			"name":  githubv4.String(repo),
+		}
+
+		err := ql.Client.GraphQL().Query(ctx, &query, vars)
+		return &query.Repository, err
+	}
+
+	return cache.FetchSingle[SecurityPolicy](ctx, coll, f)
+}
+
+type VulnerabilityAlerts struct {
+	HasVulnerabilityAlertsEnabled bool
+}
+
+// FetchVulnerabilityAlerts only succeeds for tokens with admin access, so it is queried apart from the security policy
+func (ql *GraphQLWrapper) FetchVulnerabilityAlerts(ctx context.Context, owner, repo string) (*VulnerabilityAlerts, error) {
+
+	coll := ql.Cache.Database("query_vulnerability_alerts").Collection(fmt.Sprintf("%s-%s", owner, repo))
+
+	f := func() (*VulnerabilityAlerts, error) {
+		var query struct {
+			Repository VulnerabilityAlerts `graphql:"repository(owner: $owner, name: $name)"`
+		}
+
+		vars := map[string]any{
+			"owner": githubv4.String(owner),
+			"name":  githubv4.String(repo),
+		}
+
+		err := ql.Client.GraphQL().Query(ctx, &query, vars)
+		return &query.Repository, err
+	}
+
+	return cache.FetchSingle[VulnerabilityAlerts](ctx, coll, f)
+}
+
+type FundingLink struct {
+	Platform string
+	Url      string
+}
+
+// Funding combines the parsed FUNDING.yml of the repository with the sponsors listing of its owner
+type Funding struct {
+	FundingLinks []FundingLink
+	Sponsorable  struct {
+		HasSponsorsListing       bool
+		SponsorshipsAsMaintainer struct {
+			TotalCount int
+		} `graphql:"sponsorshipsAsMaintainer(first: 1)"`
+	}
+}
+
+func (ql *GraphQLWrapper) FetchFunding(ctx context.Context, owner, repo string) (*Funding, error) {
+
+	coll := ql.Cache.Database("query_funding").Collection(fmt.Sprintf("%s-%s", owner, repo))
+
+	f := func() (*Funding, error) {
+		var query struct {
+			Repository struct {
+				FundingLinks []FundingLink
+			} `graphql:"repository(owner: $owner, name: $name)"`
+			RepositoryOwner struct {
+				Sponsorable struct {
+					HasSponsorsListing       bool
+					SponsorshipsAsMaintainer struct {
+						TotalCount int
+					} `graphql:"sponsorshipsAsMaintainer(first: 1)"`
+				} `graphql:"... on Sponsorable"`
+			} `graphql:"repositoryOwner(login: $owner)"`
+		}
+
+		vars := map[string]any{
+			"owner": githubv4.String(owner),
+			"name":  githubv4.String(repo),
+		}
+
+		if err := ql.Client.GraphQL().Query(ctx, &query, vars); err != nil {
+			return nil, err
+		}
+
+		funding := &Funding{FundingLinks: query.Repository.FundingLinks}
+		funding.Sponsorable = query.RepositoryOwner.Sponsorable
+
+		return funding, nil
+	}
+
+	return cache.FetchSingle[Funding](ctx, coll, f)
+}
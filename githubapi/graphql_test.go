@@ -0,0 +1,103 @@
+package githubapi
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/a-grasso/deprec/cache"
+	"github.com/google/go-github/v48/github"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var nodeField = regexp.MustCompile(`(field\d+):node\(id:"([^"]+)"\)`)
+
+// graphQLNodes answers batched node queries with the given nodes, unknown ids resolve to null
+func graphQLNodes(t *testing.T, nodes map[string]any) *GraphQLWrapper {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct{ Query string }
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&request))
+
+		data := map[string]any{}
+		for _, match := range nodeField.FindAllStringSubmatch(request.Query, -1) {
+			data[match[1]] = nodes[match[2]]
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": data})
+	}))
+	t.Cleanup(server.Close)
+
+	client := &Client{graphClient: githubv4.NewEnterpriseClient(server.URL, nil)}
+
+	return NewClientWrapper(client, &cache.Cache{}).GraphQL
+}
+
+func TestFetchIssueTimelines(t *testing.T) {
+
+	comment := func(login, typename, association string) map[string]any {
+		return map[string]any{
+			"author":            map[string]any{"login": login, "__typename": typename},
+			"authorAssociation": association,
+			"createdAt":         "2022-01-02T00:00:00Z",
+		}
+	}
+
+	ql := graphQLNodes(t, map[string]any{
+		"I_1": map[string]any{"number": 1, "comments": map[string]any{"totalCount": 3, "nodes": []any{
+			comment("dependabot", "Bot", "NONE"),
+			comment("maintainer", "User", "MEMBER"),
+		}}},
+		"PR_2": map[string]any{"number": 2, "comments": map[string]any{"totalCount": 0, "nodes": []any{}}},
+	})
+
+	issues := []*github.Issue{{NodeID: github.String("I_1")}, {NodeID: github.String("PR_2")}, {NodeID: github.String("DELETED_3")}}
+
+	timelines, err := ql.FetchIssueTimelines(context.TODO(), "owner", "repo", issues)
+	assert.Nil(t, err)
+
+	// the deleted node resolves to neither an issue nor a pull request and is skipped instead of ending up as number 0
+	assert.Len(t, timelines, 2)
+	assert.NotContains(t, timelines, 0)
+
+	assert.Equal(t, 3, timelines[1].Comments.TotalCount)
+	assert.Len(t, timelines[1].Comments.Nodes, 2)
+	assert.Equal(t, "Bot", timelines[1].Comments.Nodes[0].Author.Typename)
+	assert.Equal(t, "MEMBER", timelines[1].Comments.Nodes[1].AuthorAssociation)
+	assert.Equal(t, time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC), timelines[1].Comments.Nodes[1].CreatedAt)
+
+	assert.Equal(t, 2, timelines[2].Number)
+	assert.Empty(t, timelines[2].Comments.Nodes)
+}
+
+func TestBatchKey(t *testing.T) {
+
+	issue := func(id, state string, updated time.Time) *github.Issue {
+		return &github.Issue{NodeID: github.String(id), State: github.String(state), UpdatedAt: &updated}
+	}
+
+	day := func(d int) time.Time { return time.Date(2022, 1, d, 0, 0, 0, 0, time.UTC) }
+
+	key := func(issues ...*github.Issue) string { return batchKey(issues) }
+
+	closed := key(issue("I_1", "closed", day(1)), issue("I_2", "open", day(1)))
+
+	tests := []struct {
+		name  string
+		other string
+		same  bool
+	}{
+		{"order does not matter", key(issue("I_2", "open", day(1)), issue("I_1", "closed", day(1))), true},
+		{"updated closed issue", key(issue("I_1", "closed", day(5)), issue("I_2", "open", day(1))), true},
+		{"updated open issue", key(issue("I_1", "closed", day(1)), issue("I_2", "open", day(5))), false},
+		{"other issues", key(issue("I_1", "closed", day(1)), issue("I_3", "open", day(1))), false},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.same, closed == test.other, test.name)
+	}
+}
@@ -6,6 +6,9 @@ import (
 	"github.com/a-grasso/deprec/cache"
 	"github.com/google/go-github/v48/github"
 	"github.com/thoas/go-funk"
+	"sort"
+	"strings"
+	"time"
 )
 
 type ClientWrapper struct {
@@ -17,6 +20,7 @@ type ClientWrapper struct {
 	Repositories  *RepositoriesServiceWrapper
 	Organizations *OrganizationsServiceWrapper
 	Issues        *IssuesServiceWrapper
+	Actions       *ActionsServiceWrapper
 	GraphQL       *GraphQLWrapper
 }
 
@@ -28,6 +32,7 @@ type ServiceWrapper struct {
 type RepositoriesServiceWrapper ServiceWrapper
 type OrganizationsServiceWrapper ServiceWrapper
 type IssuesServiceWrapper ServiceWrapper
+type ActionsServiceWrapper ServiceWrapper
 type GraphQLWrapper ServiceWrapper
 
 func NewClientWrapper(client *Client, cache *cache.Cache) *ClientWrapper {
@@ -40,6 +45,7 @@ func NewClientWrapper(client *Client, cache *cache.Cache) *ClientWrapper {
 	wrapper.Repositories = (*RepositoriesServiceWrapper)(&wrapper.common)
 	wrapper.Organizations = (*OrganizationsServiceWrapper)(&wrapper.common)
 	wrapper.Issues = (*IssuesServiceWrapper)(&wrapper.common)
+	wrapper.Actions = (*ActionsServiceWrapper)(&wrapper.common)
 	wrapper.GraphQL = (*GraphQLWrapper)(&wrapper.common)
 
 	return wrapper
@@ -82,12 +88,40 @@ func (s *RepositoriesServiceWrapper) List(ctx context.Context, user string, opts
 	return cache.FetchPagination[*github.Repository](ctx, coll, f, &opts.ListOptions)
 }
 
+// RepositoryName is the canonical name a requested repository name resolves to, they differ for moved repositories
+type RepositoryName struct {
+	Owner string
+	Name  string
+}
+
+// Get caches a repository under its canonical name only, the requested name just keeps the redirect to it
 func (s *RepositoriesServiceWrapper) Get(ctx context.Context, owner string, repo string) (*github.Repository, error) {
 
-	coll := s.Cache.Database("repositories_get").Collection(fmt.Sprintf("%s-%s", owner, repo))
+	var fetched *github.Repository
 
-	f := func() (*github.Repository, error) {
+	redirect := s.Cache.Database("repositories_redirect").Collection(fmt.Sprintf("%s-%s", owner, repo))
+
+	resolve := func() (*RepositoryName, error) {
 		repository, _, err := s.Client.Rest().Repositories.Get(ctx, owner, repo)
+		if err != nil {
+			return nil, err
+		}
+		fetched = repository
+		return &RepositoryName{Owner: repository.GetOwner().GetLogin(), Name: repository.GetName()}, nil
+	}
+
+	name, err := cache.FetchSingle[RepositoryName](ctx, redirect, resolve)
+	if err != nil {
+		return nil, err
+	}
+
+	coll := s.Cache.Database("repositories_get").Collection(fmt.Sprintf("%s-%s", name.Owner, name.Name))
+
+	f := func() (*github.Repository, error) {
+		if fetched != nil {
+			return fetched, nil
+		}
+		repository, _, err := s.Client.Rest().Repositories.Get(ctx, name.Owner, name.Name)
 		return repository, err
 	}
 
@@ -131,7 +165,12 @@ func (s *OrganizationsServiceWrapper) Get(ctx context.Context, org string) (*git
 
 func (s *RepositoriesServiceWrapper) ListCommits(ctx context.Context, owner string, repository string, opts *github.CommitsListOptions) ([]*github.RepositoryCommit, error) {
 
-	coll := s.Cache.Database("repositories_list_commits").Collection(fmt.Sprintf("%s-%s", owner, repository))
+	name := fmt.Sprintf("%s-%s", owner, repository)
+	if opts.Path != "" {
+		name = fmt.Sprintf("%s-%s", name, strings.ReplaceAll(opts.Path, "/", "-"))
+	}
+
+	coll := s.Cache.Database("repositories_list_commits").Collection(name)
 
 	f := func() ([]*github.RepositoryCommit, *github.Response, error) {
 		return s.Client.Rest().Repositories.ListCommits(ctx, owner, repository, opts)
@@ -195,3 +234,122 @@ func (s *RepositoriesServiceWrapper) GetCommit(ctx context.Context, owner string
 
 	return cache.FetchSingle[github.RepositoryCommit](ctx, coll, f)
 }
+
+type PrivateVulnerabilityReporting struct {
+	Enabled bool `json:"enabled"`
+}
+
+// GetPrivateVulnerabilityReporting is not covered by the rest client yet, hence the hand-made request
+func (s *RepositoriesServiceWrapper) GetPrivateVulnerabilityReporting(ctx context.Context, owner string, repository string) (*PrivateVulnerabilityReporting, error) {
+
+	coll := s.Cache.Database("repositories_get_private_vulnerability_reporting").Collection(fmt.Sprintf("%s-%s", owner, repository))
+
+	f := func() (*PrivateVulnerabilityReporting, error) {
+		req, err := s.Client.Rest().NewRequest("GET", fmt.Sprintf("repos/%s/%s/private-vulnerability-reporting", owner, repository), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		reporting := new(PrivateVulnerabilityReporting)
+		_, err = s.Client.Rest().Do(ctx, req, reporting)
+		return reporting, err
+	}
+
+	return cache.FetchSingle[PrivateVulnerabilityReporting](ctx, coll, f)
+}
+
+func (s *RepositoriesServiceWrapper) ListDirectory(ctx context.Context, owner string, repository string, path string) ([]*github.RepositoryContent, error) {
+
+	name := fmt.Sprintf("%s-%s", owner, repository)
+	if path != "" {
+		name = fmt.Sprintf("%s-%s", name, strings.ReplaceAll(path, "/", "-"))
+	}
+
+	coll := s.Cache.Database("repositories_list_directory").Collection(name)
+
+	f := func() ([]*github.RepositoryContent, error) {
+		_, directory, _, err := s.Client.Rest().Repositories.GetContents(ctx, owner, repository, path, &github.RepositoryContentGetOptions{})
+		return directory, err
+	}
+
+	return cache.FetchMultiple[*github.RepositoryContent](ctx, coll, f)
+}
+
+func (s *ActionsServiceWrapper) ListWorkflows(ctx context.Context, owner string, repository string) ([]*github.Workflow, error) {
+
+	coll := s.Cache.Database("actions_list_workflows").Collection(fmt.Sprintf("%s-%s", owner, repository))
+
+	f := func() ([]*github.Workflow, error) {
+		workflows, _, err := s.Client.Rest().Actions.ListWorkflows(ctx, owner, repository, &github.ListOptions{PerPage: 100})
+		if err != nil {
+			return nil, err
+		}
+		return workflows.Workflows, nil
+	}
+
+	return cache.FetchMultiple[*github.Workflow](ctx, coll, f)
+}
+
+// recentWorkflowRuns is how many of the latest runs of a branch are of interest, the whole run history rarely is
+const recentWorkflowRuns = 100
+
+// ListRecentWorkflowRuns returns the latest runs of a branch, newest first. The cached runs are refreshed with those created
+// since, runs still in progress when cached are replaced by their completed state
+func (s *ActionsServiceWrapper) ListRecentWorkflowRuns(ctx context.Context, owner string, repository string, branch string) ([]*github.WorkflowRun, error) {
+
+	coll := s.Cache.Database("actions_list_recent_workflow_runs").Collection(fmt.Sprintf("%s-%s-%s", owner, repository, branch))
+
+	f := func(since *time.Time) ([]*github.WorkflowRun, error) {
+		opts := &github.ListWorkflowRunsOptions{Branch: branch, ListOptions: github.ListOptions{PerPage: recentWorkflowRuns}}
+		if since != nil {
+			opts.Created = ">=" + since.UTC().Format(time.RFC3339)
+		}
+
+		runs, _, err := s.Client.Rest().Actions.ListRepositoryWorkflowRuns(ctx, owner, repository, opts)
+		if err != nil {
+			return nil, err
+		}
+		return runs.WorkflowRuns, nil
+	}
+
+	timestamp := func(run *github.WorkflowRun) time.Time { return run.GetCreatedAt().Time }
+	key := func(run *github.WorkflowRun) string { return fmt.Sprintf("%d-%s", run.GetID(), run.GetStatus()) }
+
+	runs, err := cache.FetchIncremental[*github.WorkflowRun](ctx, coll, f, timestamp, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return latestRuns(runs), nil
+}
+
+// latestRuns keeps one state per run, preferring the completed one, and trims them to the most recent runs
+func latestRuns(runs []*github.WorkflowRun) []*github.WorkflowRun {
+
+	byID := make(map[int64]*github.WorkflowRun)
+	var ids []int64
+	for _, run := range runs {
+		known, exists := byID[run.GetID()]
+		if !exists {
+			ids = append(ids, run.GetID())
+		}
+		if !exists || known.GetStatus() != "completed" {
+			byID[run.GetID()] = run
+		}
+	}
+
+	var result []*github.WorkflowRun
+	for _, id := range ids {
+		result = append(result, byID[id])
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].GetCreatedAt().After(result[j].GetCreatedAt().Time)
+	})
+
+	if len(result) > recentWorkflowRuns {
+		result = result[:recentWorkflowRuns]
+	}
+
+	return result
+}
@@ -0,0 +1,60 @@
+package githubapi
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/a-grasso/deprec/cache"
+	"github.com/a-grasso/deprec/configuration"
+	"github.com/google/go-github/v48/github"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+var config, _ = configuration.Load("./../config/config.json", "./../config/ut.env")
+
+func TestGetCachesMovedRepositoryUnderCanonicalName(t *testing.T) {
+
+	if config == nil {
+		t.Skip("the redirect cache needs the mongodb of the test config")
+	}
+
+	c, err := cache.NewCache(config.MongoDB)
+	if err != nil || c.Client == nil {
+		t.Skip("the redirect cache needs the mongodb of the test config")
+	}
+
+	t.Cleanup(func() {
+		for _, database := range []string{"repositories_redirect", "repositories_get"} {
+			_ = c.Client.Database(database).Drop(context.TODO())
+		}
+	})
+
+	requests := make(map[string]int)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+		_ = json.NewEncoder(w).Encode(github.Repository{
+			FullName: github.String("new-owner/repo"),
+			Name:     github.String("repo"),
+			Owner:    &github.User{Login: github.String("new-owner")},
+		})
+	}))
+	defer server.Close()
+
+	rest := github.NewClient(nil)
+	rest.BaseURL, _ = url.Parse(server.URL + "/")
+
+	wrapper := NewClientWrapper(&Client{restClient: rest}, c)
+
+	for _, owner := range []string{"old-owner", "old-owner", "new-owner", "new-owner"} {
+		repository, err := wrapper.Repositories.Get(context.TODO(), owner, "repo")
+		assert.Nil(t, err)
+		assert.Equal(t, "new-owner/repo", repository.GetFullName())
+	}
+
+	// each name is resolved once, the repository itself is fetched once and served under its canonical name
+	assert.Equal(t, map[string]int{"/repos/old-owner/repo": 1, "/repos/new-owner/repo": 1}, requests)
+}
@@ -0,0 +1,201 @@
+package knowledgebase
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/a-grasso/deprec/model"
+	"github.com/a-grasso/deprec/versioning"
+	"gopkg.in/yaml.v3"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Entry is one record of a knowledge base file, e.g.
+//
+//	entries:
+//	  - purl: pkg:maven/commons-httpclient/*
+//	    versions: "< 4.0"
+//	    verdict: replaced
+//	    reason: end of life since 2011
+//	    successor: org.apache.httpcomponents:httpclient
+//	    expires: 2025-12-31
+//	    override: true
+type Entry struct {
+	PackageURL string        `yaml:"purl" json:"purl"`
+	Versions   string        `yaml:"versions" json:"versions"`
+	Verdict    model.Verdict `yaml:"verdict" json:"verdict"`
+	Reason     string        `yaml:"reason" json:"reason"`
+	Successor  string        `yaml:"successor" json:"successor"`
+	Expires    string        `yaml:"expires" json:"expires"`
+	Override   bool          `yaml:"override" json:"override"`
+
+	source   string
+	pattern  *regexp.Regexp
+	versions *versioning.Range
+	expires  *time.Time
+}
+
+type file struct {
+	Entries []Entry `yaml:"entries" json:"entries"`
+}
+
+type KnowledgeBase struct {
+	Entries []Entry
+}
+
+// Load reads every yaml and json file of the directory, invalid entries fail the whole load
+func Load(directory string) (*KnowledgeBase, error) {
+
+	files, err := os.ReadDir(directory)
+	if err != nil {
+		return nil, fmt.Errorf("could not read knowledge base directory '%s': %s", directory, err)
+	}
+
+	result := &KnowledgeBase{}
+
+	for _, f := range files {
+
+		extension := strings.ToLower(filepath.Ext(f.Name()))
+		if f.IsDir() || (extension != ".yaml" && extension != ".yml" && extension != ".json") {
+			continue
+		}
+
+		entries, err := loadFile(filepath.Join(directory, f.Name()), extension)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Entries = append(result.Entries, entries...)
+	}
+
+	return result, nil
+}
+
+func loadFile(path, extension string) ([]Entry, error) {
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read knowledge base file '%s': %s", path, err)
+	}
+
+	var parsed file
+	if extension == ".json" {
+		err = json.Unmarshal(content, &parsed)
+	} else {
+		err = yaml.Unmarshal(content, &parsed)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not parse knowledge base file '%s': %s", path, err)
+	}
+
+	for i := range parsed.Entries {
+		if err := parsed.Entries[i].prepare(filepath.Base(path)); err != nil {
+			return nil, fmt.Errorf("invalid entry %d in knowledge base file '%s': %s", i+1, path, err)
+		}
+	}
+
+	return parsed.Entries, nil
+}
+
+func (e *Entry) prepare(source string) error {
+
+	e.source = source
+
+	if e.PackageURL == "" {
+		return fmt.Errorf("purl is missing")
+	}
+
+	e.pattern = compilePattern(e.PackageURL)
+
+	if !e.Verdict.IsKnown() {
+		return fmt.Errorf("unknown verdict '%s'", e.Verdict)
+	}
+
+	versions, err := versioning.ParseRange(e.Versions)
+	if err != nil {
+		return err
+	}
+	e.versions = versions
+
+	if e.Expires != "" {
+		expires, err := time.Parse("2006-01-02", e.Expires)
+		if err != nil {
+			return fmt.Errorf("could not parse expiry date '%s': %s", e.Expires, err)
+		}
+		e.expires = &expires
+	}
+
+	return nil
+}
+
+// compilePattern turns a purl pattern into an anchored, case-insensitive expression where '*' matches anything
+func compilePattern(pattern string) *regexp.Regexp {
+
+	parts := strings.Split(pattern, "*")
+	for i, part := range parts {
+		parts[i] = regexp.QuoteMeta(part)
+	}
+
+	return regexp.MustCompile("(?i)^" + strings.Join(parts, ".*") + "$")
+}
+
+// Lookup returns the most specific entry matching the dependency, expired entries are ignored
+func (kb *KnowledgeBase) Lookup(dependency model.Dependency, now time.Time) *model.Knowledge {
+
+	version := dependency.Version
+	candidates := []string{dependency.Name}
+
+	if purl, err := model.ParsePackageURL(dependency.PackageURL); err == nil {
+		candidates = append([]string{fmt.Sprintf("pkg:%s/%s", purl.Type, purl.FullName())}, candidates...)
+		if purl.Version != "" {
+			version = purl.Version
+		}
+	}
+
+	var match *Entry
+	for i, entry := range kb.Entries {
+
+		if entry.expires != nil && entry.expires.Before(now) {
+			continue
+		}
+
+		if entry.Versions != "" && (version == "" || !entry.versions.Contains(version)) {
+			continue
+		}
+
+		if !matchesAny(entry.pattern, candidates) {
+			continue
+		}
+
+		if match == nil || len(entry.PackageURL) > len(match.PackageURL) {
+			match = &kb.Entries[i]
+		}
+	}
+
+	if match == nil {
+		return nil
+	}
+
+	return &model.Knowledge{
+		Pattern:   match.PackageURL,
+		Versions:  match.Versions,
+		Verdict:   match.Verdict,
+		Reason:    match.Reason,
+		Successor: match.Successor,
+		Expires:   match.expires,
+		Override:  match.Override,
+		Source:    match.source,
+	}
+}
+
+func matchesAny(pattern *regexp.Regexp, candidates []string) bool {
+	for _, candidate := range candidates {
+		if pattern.MatchString(candidate) {
+			return true
+		}
+	}
+	return false
+}
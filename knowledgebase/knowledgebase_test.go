@@ -0,0 +1,85 @@
+package knowledgebase
+
+import (
+	"github.com/a-grasso/deprec/model"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const team = `
+entries:
+  - purl: pkg:maven/log4j/*
+    verdict: replaced
+    reason: end of life since 2015
+    successor: org.apache.logging.log4j:log4j-core
+    override: true
+  - purl: pkg:maven/log4j/log4j
+    versions: "< 1.2.17"
+    verdict: banned
+    reason: known vulnerabilities
+  - purl: pkg:npm/request
+    verdict: deprecated
+    expires: 2020-01-01
+`
+
+const approved = `{"entries": [{"purl": "commons-lang3", "verdict": "approved"}]}`
+
+func writeKnowledgeBase(t *testing.T, files map[string]string) string {
+	directory := t.TempDir()
+	for name, content := range files {
+		err := os.WriteFile(filepath.Join(directory, name), []byte(content), 0o644)
+		assert.Nil(t, err)
+	}
+	return directory
+}
+
+func TestLookup(t *testing.T) {
+
+	kb, err := Load(writeKnowledgeBase(t, map[string]string{"team.yaml": team, "approved.json": approved, "notes.txt": "ignored"}))
+	assert.Nil(t, err)
+	assert.Len(t, kb.Entries, 4)
+
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	knowledge := kb.Lookup(model.Dependency{Name: "log4j", PackageURL: "pkg:maven/log4j/log4j@1.2.16"}, now)
+	assert.NotNil(t, knowledge)
+	assert.Equal(t, model.Banned, knowledge.Verdict)
+	assert.Equal(t, "team.yaml", knowledge.Source)
+
+	knowledge = kb.Lookup(model.Dependency{Name: "log4j", PackageURL: "pkg:maven/log4j/log4j@1.2.17"}, now)
+	assert.NotNil(t, knowledge)
+	assert.Equal(t, model.Replaced, knowledge.Verdict)
+	assert.True(t, knowledge.Override)
+	assert.Equal(t, "org.apache.logging.log4j:log4j-core", knowledge.Successor)
+
+	knowledge = kb.Lookup(model.Dependency{Name: "commons-lang3", Version: "3.12.0"}, now)
+	assert.NotNil(t, knowledge)
+	assert.Equal(t, model.Approved, knowledge.Verdict)
+	assert.Equal(t, model.NoConcerns, knowledge.Verdict.Recommendation())
+}
+
+func TestLookupExpired(t *testing.T) {
+
+	kb, err := Load(writeKnowledgeBase(t, map[string]string{"team.yml": team}))
+	assert.Nil(t, err)
+
+	dependency := model.Dependency{Name: "request", PackageURL: "pkg:npm/request@2.88.2"}
+
+	assert.Nil(t, kb.Lookup(dependency, time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)))
+	assert.NotNil(t, kb.Lookup(dependency, time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestLoadInvalid(t *testing.T) {
+
+	_, err := Load(writeKnowledgeBase(t, map[string]string{"broken.yaml": "entries:\n  - purl: pkg:npm/left-pad\n    verdict: dead\n"}))
+	assert.NotNil(t, err)
+
+	_, err = Load(writeKnowledgeBase(t, map[string]string{"broken.yaml": "entries:\n  - verdict: banned\n"}))
+	assert.NotNil(t, err)
+
+	_, err = Load(filepath.Join(t.TempDir(), "missing"))
+	assert.NotNil(t, err)
+}
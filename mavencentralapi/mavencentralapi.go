@@ -4,31 +4,75 @@ import (
 	"encoding/json"
 	"encoding/xml"
 	"fmt"
+	"github.com/a-grasso/deprec/configuration"
+	"github.com/a-grasso/deprec/model"
 	"github.com/vifraa/gopom"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 )
 
+type SearchAPI string
+
+const (
+	Solr        SearchAPI = "solr"
+	Nexus       SearchAPI = "nexus"
+	Artifactory SearchAPI = "artifactory"
+)
+
 type Client struct {
-	BaseURLSHASearch      string
+	SearchAPI             SearchAPI
+	BaseURLSearch         string
 	BaseURLBrowseArtifact string
 	BaseURLBrowseLibrary  string
 	BasePOMName           string
 	MetadataName          string
+
+	// searchKey and repositoryKey tell the configured repositories apart in the cache
+	searchKey     string
+	repositoryKey string
 }
 
-func NewClient() *Client {
+func NewClient(config configuration.MavenCentral) *Client {
+
+	repositoryURL := "https://repo1.maven.org/maven2"
+	if config.RepositoryURL != "" {
+		repositoryURL = strings.TrimSuffix(config.RepositoryURL, "/")
+	}
+
+	searchURL := "https://search.maven.org/solrsearch/select"
+	if config.SearchURL != "" {
+		searchURL = strings.TrimSuffix(config.SearchURL, "/")
+	}
+
+	searchAPI := Solr
+	if config.SearchAPI != "" {
+		searchAPI = SearchAPI(strings.ToLower(config.SearchAPI))
+	}
 
 	return &Client{
-		BaseURLSHASearch:      "https://search.maven.org/solrsearch/select?q=1:%s&rows=20&wt=json",
-		BaseURLBrowseArtifact: "https://repo1.maven.org/maven2/%s/%s/%s/%s.%s",
-		BaseURLBrowseLibrary:  "https://repo1.maven.org/maven2/%s/%s/%s.%s",
+		SearchAPI:             searchAPI,
+		BaseURLSearch:         searchURL,
+		BaseURLBrowseArtifact: repositoryURL + "/%s/%s/%s/%s.%s",
+		BaseURLBrowseLibrary:  repositoryURL + "/%s/%s/%s.%s",
 		BasePOMName:           "%s-%s",
 		MetadataName:          "maven-metadata",
+
+		searchKey:     fmt.Sprintf("%s-%s", searchAPI, cacheKey(searchURL)),
+		repositoryKey: cacheKey(repositoryURL),
 	}
 }
 
+// cacheKey is the host and path of a repository url, e.g. 'nexus.example.com_service_rest_v1_search'
+func cacheKey(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return strings.ReplaceAll(rawURL, "/", "_")
+	}
+	return strings.ReplaceAll(strings.Trim(u.Host+u.Path, "/"), "/", "_")
+}
+
 type MavenCentralSearch struct {
 	ResponseHeader struct {
 		Status int `json:"status"`
@@ -46,37 +90,194 @@ type MavenCentralSearch struct {
 		} `json:"params"`
 	} `json:"responseHeader"`
 	Response struct {
-		NumFound int `json:"numFound"`
-		Start    int `json:"start"`
-		Docs     []struct {
-			ID        string   `json:"id"`
-			G         string   `json:"g"`
-			A         string   `json:"a"`
-			V         string   `json:"v"`
-			P         string   `json:"p"`
-			Timestamp int64    `json:"timestamp"`
-			Ec        []string `json:"ec"`
-			Tags      []string `json:"tags"`
-		} `json:"docs"`
+		NumFound int         `json:"numFound"`
+		Start    int         `json:"start"`
+		Docs     []SearchDoc `json:"docs"`
 	} `json:"response"`
 }
 
-func (c *Client) SearchMavenCentralSHA1(sha1 string) (*MavenCentralSearch, error) {
-	url := fmt.Sprintf(c.BaseURLSHASearch, sha1)
+type SearchDoc struct {
+	ID        string   `json:"id"`
+	G         string   `json:"g"`
+	A         string   `json:"a"`
+	V         string   `json:"v"`
+	P         string   `json:"p"`
+	Timestamp int64    `json:"timestamp"`
+	Ec        []string `json:"ec"`
+	Tags      []string `json:"tags"`
+}
+
+// SupportsHash tells whether the search api can look up artifacts by hashes of the algorithm, solr only indexes sha1
+func (c *Client) SupportsHash(algorithm model.HashAlgorithm) bool {
+	switch c.SearchAPI {
+	case Solr:
+		return algorithm == model.SHA1
+	case Nexus, Artifactory:
+		_, supported := hashParameters[algorithm]
+		return supported
+	}
+	return false
+}
 
-	resp, err := http.Get(url)
+func (c *Client) SearchHash(algorithm model.HashAlgorithm, hash string) (*MavenCentralSearch, error) {
+	if !c.SupportsHash(algorithm) {
+		return nil, fmt.Errorf("%s search does not support hash algorithm '%s'", c.SearchAPI, algorithm)
+	}
+
+	switch c.SearchAPI {
+	case Solr:
+		return c.searchSolr(fmt.Sprintf("1:%s", hash), "", 20, 0)
+	case Nexus:
+		return c.searchNexus(algorithm, hash)
+	case Artifactory:
+		return c.searchArtifactory(algorithm, hash)
+	}
+
+	return nil, fmt.Errorf("unknown search api '%s'", c.SearchAPI)
+}
+
+func (c *Client) SearchGAV(groupId, artifactId, version string) (*MavenCentralSearch, error) {
+	if c.SearchAPI != Solr {
+		return nil, fmt.Errorf("search api '%s' does not support coordinate search", c.SearchAPI)
+	}
+
+	return c.searchSolr(fmt.Sprintf("g:\"%s\" AND a:\"%s\" AND v:\"%s\"", groupId, artifactId, version), "gav", 1, 0)
+}
+
+// versionsPageSize is the most rows solr returns per request
+const versionsPageSize = 200
+
+// SearchVersions pages through all versions, large libraries have far more than fit into one page
+func (c *Client) SearchVersions(groupId, artifactId string) (*MavenCentralSearch, error) {
+	if c.SearchAPI != Solr {
+		return nil, fmt.Errorf("search api '%s' does not support version search", c.SearchAPI)
+	}
+
+	query := fmt.Sprintf("g:\"%s\" AND a:\"%s\"", groupId, artifactId)
+
+	result, err := c.searchSolr(query, "gav", versionsPageSize, 0)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
+
+	for len(result.Response.Docs) < result.Response.NumFound {
+
+		page, err := c.searchSolr(query, "gav", versionsPageSize, len(result.Response.Docs))
+		if err != nil {
+			return nil, err
+		}
+
+		if len(page.Response.Docs) == 0 {
+			break
+		}
+
+		result.Response.Docs = append(result.Response.Docs, page.Response.Docs...)
+	}
+
+	return result, nil
+}
+
+func (c *Client) searchSolr(query, core string, rows, start int) (*MavenCentralSearch, error) {
+	params := url.Values{}
+	params.Set("q", query)
+	params.Set("rows", fmt.Sprint(rows))
+	if start != 0 {
+		params.Set("start", fmt.Sprint(start))
+	}
+	params.Set("wt", "json")
+	if core != "" {
+		params.Set("core", core)
+	}
 
 	var j MavenCentralSearch
-	err = json.NewDecoder(resp.Body).Decode(&j)
+	err := getJSON(fmt.Sprintf("%s?%s", c.BaseURLSearch, params.Encode()), &j)
+	if err != nil {
+		return nil, err
+	}
+
+	return &j, nil
+}
+
+var hashParameters = map[model.HashAlgorithm]string{
+	model.SHA1:   "sha1",
+	model.SHA256: "sha256",
+	model.MD5:    "md5",
+}
+
+func (c *Client) searchNexus(algorithm model.HashAlgorithm, hash string) (*MavenCentralSearch, error) {
+	parameter := hashParameters[algorithm]
+
+	var j struct {
+		Items []struct {
+			Group   string `json:"group"`
+			Name    string `json:"name"`
+			Version string `json:"version"`
+		} `json:"items"`
+	}
+	err := getJSON(fmt.Sprintf("%s/service/rest/v1/search?%s=%s", c.BaseURLSearch, parameter, url.QueryEscape(hash)), &j)
 	if err != nil {
 		return nil, err
 	}
 
-	return &j, err
+	var result MavenCentralSearch
+	for _, item := range j.Items {
+		result.Response.Docs = append(result.Response.Docs, SearchDoc{G: item.Group, A: item.Name, V: item.Version})
+	}
+	result.Response.NumFound = len(result.Response.Docs)
+
+	return &result, nil
+}
+
+func (c *Client) searchArtifactory(algorithm model.HashAlgorithm, hash string) (*MavenCentralSearch, error) {
+	parameter := hashParameters[algorithm]
+
+	var j struct {
+		Results []struct {
+			URI string `json:"uri"`
+		} `json:"results"`
+	}
+	err := getJSON(fmt.Sprintf("%s/api/search/checksum?%s=%s", c.BaseURLSearch, parameter, url.QueryEscape(hash)), &j)
+	if err != nil {
+		return nil, err
+	}
+
+	var result MavenCentralSearch
+	for _, r := range j.Results {
+		// e.g. .../api/storage/<repository>/org/acme/lib/1.0/lib-1.0.jar
+		_, path, found := strings.Cut(r.URI, "/api/storage/")
+		if !found {
+			continue
+		}
+
+		segments := strings.Split(path, "/")
+		if len(segments) < 5 {
+			continue
+		}
+
+		n := len(segments)
+		result.Response.Docs = append(result.Response.Docs, SearchDoc{
+			G: strings.Join(segments[1:n-3], "."),
+			A: segments[n-3],
+			V: segments[n-2],
+		})
+	}
+	result.Response.NumFound = len(result.Response.Docs)
+
+	return &result, nil
+}
+
+func getJSON(url string, v any) error {
+	resp, err := http.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("'%s' responded with status %d", url, resp.StatusCode)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
 }
 
 func (c *Client) GetArtifactPom(groupId, artifactId, version string) (*gopom.Project, error) {
@@ -0,0 +1,41 @@
+package mavencentralapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/a-grasso/deprec/configuration"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func TestSearchVersionsPages(t *testing.T) {
+
+	total := 450
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		start, _ := strconv.Atoi(r.URL.Query().Get("start"))
+		rows, _ := strconv.Atoi(r.URL.Query().Get("rows"))
+
+		var search MavenCentralSearch
+		search.Response.NumFound = total
+		for i := start; i < start+rows && i < total; i++ {
+			search.Response.Docs = append(search.Response.Docs, SearchDoc{V: fmt.Sprintf("1.%d", i)})
+		}
+
+		_ = json.NewEncoder(w).Encode(search)
+	}))
+	defer server.Close()
+
+	client := NewClient(configuration.MavenCentral{SearchURL: server.URL})
+
+	search, err := client.SearchVersions("com.google.guava", "guava")
+
+	assert.Nil(t, err)
+	assert.Len(t, search.Response.Docs, total)
+	assert.Equal(t, "1.0", search.Response.Docs[0].V)
+	assert.Equal(t, "1.449", search.Response.Docs[total-1].V)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/a-grasso/deprec/cache"
+	"github.com/a-grasso/deprec/model"
 	"github.com/vifraa/gopom"
 )
 
@@ -19,12 +20,38 @@ func NewClientWrapper(client *Client, cache *cache.Cache) *ClientWrapper {
 	}
 }
 
-func (cw *ClientWrapper) SearchMavenCentralSHA1(sha1 string) (*MavenCentralSearch, error) {
+// searchCollection keys cached search results by the configured search api and its url as well
+func (cw *ClientWrapper) searchCollection(database, key string) *cache.Collection {
+	return cw.Cache.Database(database).Collection(fmt.Sprintf("%s-%s", cw.Client.searchKey, key))
+}
+
+// repositoryCollection keys cached repository content by the configured repository url as well
+func (cw *ClientWrapper) repositoryCollection(database, key string) *cache.Collection {
+	return cw.Cache.Database(database).Collection(fmt.Sprintf("%s-%s", cw.Client.repositoryKey, key))
+}
+
+func (cw *ClientWrapper) SupportsHash(algorithm model.HashAlgorithm) bool {
+	return cw.Client.SupportsHash(algorithm)
+}
+
+func (cw *ClientWrapper) SearchHash(algorithm model.HashAlgorithm, hash string) (*MavenCentralSearch, error) {
+
+	coll := cw.searchCollection("mavencentral_search_sha", fmt.Sprintf("%s-%s", algorithm, hash))
+
+	f := func() (*MavenCentralSearch, error) {
+		reports, err := cw.Client.SearchHash(algorithm, hash)
+		return reports, err
+	}
+
+	return cache.FetchSingle[MavenCentralSearch](context.TODO(), coll, f)
+}
+
+func (cw *ClientWrapper) SearchGAV(groupId, artifactId, version string) (*MavenCentralSearch, error) {
 
-	coll := cw.Cache.Database("mavencentral_search_sha").Collection(sha1)
+	coll := cw.searchCollection("mavencentral_search_gav", fmt.Sprintf("%s-%s-%s", groupId, artifactId, version))
 
 	f := func() (*MavenCentralSearch, error) {
-		reports, err := cw.Client.SearchMavenCentralSHA1(sha1)
+		reports, err := cw.Client.SearchGAV(groupId, artifactId, version)
 		return reports, err
 	}
 
@@ -33,7 +60,7 @@ func (cw *ClientWrapper) SearchMavenCentralSHA1(sha1 string) (*MavenCentralSearc
 
 func (cw *ClientWrapper) GetArtifactPom(groupId, artifactId, version string) (*gopom.Project, error) {
 
-	coll := cw.Cache.Database("mavencentral_browse_pom").Collection(fmt.Sprintf("%s-%s-%s", groupId, artifactId, version))
+	coll := cw.repositoryCollection("mavencentral_browse_pom", fmt.Sprintf("%s-%s-%s", groupId, artifactId, version))
 
 	f := func() (*gopom.Project, error) {
 		reports, err := cw.Client.GetArtifactPom(groupId, artifactId, version)
@@ -45,7 +72,7 @@ func (cw *ClientWrapper) GetArtifactPom(groupId, artifactId, version string) (*g
 
 func (cw *ClientWrapper) GetLibraryMetadata(groupId, artifactId string) (*Metadata, error) {
 
-	coll := cw.Cache.Database("mavencentral_browse_metadata").Collection(fmt.Sprintf("%s-%s", groupId, artifactId))
+	coll := cw.repositoryCollection("mavencentral_browse_metadata", fmt.Sprintf("%s-%s", groupId, artifactId))
 
 	f := func() (*Metadata, error) {
 		reports, err := cw.Client.GetLibraryMetadata(groupId, artifactId)
@@ -54,3 +81,15 @@ func (cw *ClientWrapper) GetLibraryMetadata(groupId, artifactId string) (*Metada
 
 	return cache.FetchSingle[Metadata](context.TODO(), coll, f)
 }
+
+func (cw *ClientWrapper) SearchVersions(groupId, artifactId string) (*MavenCentralSearch, error) {
+
+	coll := cw.searchCollection("mavencentral_search_all_versions", fmt.Sprintf("%s-%s", groupId, artifactId))
+
+	f := func() (*MavenCentralSearch, error) {
+		reports, err := cw.Client.SearchVersions(groupId, artifactId)
+		return reports, err
+	}
+
+	return cache.FetchSingle[MavenCentralSearch](context.TODO(), coll, f)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/thoas/go-funk"
 	"math"
+	"sort"
 )
 
 type CoreName string
@@ -31,6 +32,11 @@ const (
 	Engagement         CoreName = "Engagement"
 	Licensing          CoreName = "Licensing"
 	Marking            CoreName = "Marking"
+	KnowledgeBase      CoreName = "Knowledge Base"
+	Maintained         CoreName = "Maintained"
+	PullRequests       CoreName = "Pull Requests"
+	Automation         CoreName = "Automation"
+	BuildHealth        CoreName = "Build Health"
 )
 
 const (
@@ -49,6 +55,9 @@ type Core struct {
 	DecisionMaking float64
 
 	UnderlyingCores map[float64][]Core
+
+	// Statements are the intakes of this core, underlying cores keep their own
+	Statements []Statement
 }
 
 func NewCore(core CoreName) *Core {
@@ -138,6 +147,7 @@ func (cr *Core) Normalized() Core {
 		Watchlist:         cr.Watchlist / total,
 		DecisionMaking:    cr.DecisionMaking / total,
 		UnderlyingCores:   cr.UnderlyingCores,
+		Statements:        cr.Statements,
 	}
 }
 
@@ -166,13 +176,24 @@ func (cr *Core) HighestPossibleValue() float64 {
 }
 
 func (cr *Core) IntakeThreshold(value, threshold, weight float64) {
+	cr.intakeThreshold(Statement{}, value, threshold, weight)
+}
+
+func (cr *Core) intakeThreshold(s Statement, value, threshold, weight float64) {
 
 	v := math.Min(1, value/threshold)
 
-	cr.Intake(v, weight)
+	s.Value = value
+	s.Threshold = &threshold
+
+	cr.take(s, v, weight)
 }
 
 func (cr *Core) IntakeLimit(value, limit, weight float64) {
+	cr.intakeLimit(Statement{}, value, limit, weight)
+}
+
+func (cr *Core) intakeLimit(s Statement, value, limit, weight float64) {
 
 	r := value / limit
 	v := math.Max(0, 1-r)
@@ -184,36 +205,70 @@ func (cr *Core) IntakeLimit(value, limit, weight float64) {
 	v = math.Max(0, v)
 	v = math.Min(1, v)
 
-	cr.Intake(v, weight)
+	s.Value = value
+	s.Limit = &limit
+
+	cr.take(s, v, weight)
 }
 
 func (cr *Core) Intake(value float64, weight float64) {
+	cr.take(Statement{Value: value}, value, weight)
+}
 
-	if value > 1 {
+// take adds the weight to the bucket of the normalized value and records the statement, weightless intakes leave no trace
+func (cr *Core) take(s Statement, value float64, weight float64) {
+
+	bucket, exists := bucketOf(value)
+	if !exists {
 		return
 	}
 
-	if value >= 0.75 {
+	switch bucket {
+	case NoConcerns:
 		cr.NoConcerns += weight
+	case NoImmediateAction:
+		cr.NoImmediateAction += weight
+	case Watchlist:
+		cr.Watchlist += weight
+	case DecisionMaking:
+		cr.DecisionMaking += weight
+	}
+
+	if weight == 0 {
 		return
 	}
 
+	s.Core = cr.Name
+	s.Normalized = value
+	s.Bucket = bucket
+	s.Weight = weight
+
+	cr.Statements = append(cr.Statements, s)
+}
+
+func bucketOf(value float64) (Recommendation, bool) {
+
+	if value > 1 {
+		return "", false
+	}
+
+	if value >= 0.75 {
+		return NoConcerns, true
+	}
+
 	if value >= 0.5 {
-		cr.NoImmediateAction += weight
-		return
+		return NoImmediateAction, true
 	}
 
 	if value >= 0.25 {
-		cr.Watchlist += weight
-		return
+		return Watchlist, true
 	}
 
 	if value >= 0 {
-		cr.DecisionMaking += weight
-		return
+		return DecisionMaking, true
 	}
 
-	return
+	return "", false
 }
 
 func (cr *Core) Overtake(from Core, weight float64) {
@@ -227,3 +282,20 @@ func (cr *Core) Overtake(from Core, weight float64) {
 
 	cr.UnderlyingCores[weight] = append(cr.UnderlyingCores[weight], from)
 }
+
+// AllStatements collects the statements of this core and all cores underneath it
+func (cr *Core) AllStatements() []Statement {
+
+	result := append([]Statement{}, cr.Statements...)
+
+	weights := funk.Keys(cr.UnderlyingCores).([]float64)
+	sort.Float64s(weights)
+
+	for i := len(weights) - 1; i >= 0; i-- {
+		for _, underlying := range cr.UnderlyingCores[weights[i]] {
+			result = append(result, underlying.AllStatements()...)
+		}
+	}
+
+	return result
+}
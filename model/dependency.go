@@ -4,8 +4,10 @@ type HashAlgorithm string
 type ExternalReference string
 
 const (
-	SHA1 HashAlgorithm     = "SHA-1"
-	VCS  ExternalReference = "vcs"
+	SHA1   HashAlgorithm     = "SHA-1"
+	SHA256 HashAlgorithm     = "SHA-256"
+	MD5    HashAlgorithm     = "MD5"
+	VCS    ExternalReference = "vcs"
 )
 
 type Dependency struct {
@@ -1,17 +1,70 @@
 package model
 
 import (
+	"fmt"
+	"strings"
 	"time"
 )
 
 type DataModel struct {
+	Dependency         Dependency
 	Repository         *Repository
 	Distribution       *Distribution
 	VulnerabilityIndex *VulnerabilityIndex
+	Lifecycle          *Lifecycle
+	Knowledge          *Knowledge
+	Scorecard          *Scorecard
+	LineSupport        *LineSupport // derived from the releases before the cores evaluate
 }
 
 type VulnerabilityIndex struct {
 	TotalVulnerabilitiesCount int
+	CVEs                      []string // of the vulnerabilities oss index reports for the used version
+	Advisories                []Advisory
+}
+
+// Advisory is a published github security advisory for the package, Affected tells whether the used version is in range
+type Advisory struct {
+	ID                     string
+	CVEs                   []string
+	Summary                string
+	Severity               string
+	VulnerableVersionRange string
+	FirstPatchedVersion    string
+	PublishedAt            time.Time
+	Affected               bool
+}
+
+// AffectingAdvisories are the advisories whose vulnerable range contains the used version
+func (vi *VulnerabilityIndex) AffectingAdvisories() []Advisory {
+	var result []Advisory
+	for _, advisory := range vi.Advisories {
+		if advisory.Affected {
+			result = append(result, advisory)
+		}
+	}
+	return result
+}
+
+// UnreportedAdvisories are the affecting advisories without a cve that oss index already reports
+func (vi *VulnerabilityIndex) UnreportedAdvisories() []Advisory {
+
+	reported := make(map[string]bool)
+	for _, cve := range vi.CVEs {
+		reported[strings.ToUpper(cve)] = true
+	}
+
+	var result []Advisory
+	for _, advisory := range vi.AffectingAdvisories() {
+		known := false
+		for _, cve := range advisory.CVEs {
+			known = known || reported[strings.ToUpper(cve)]
+		}
+		if !known {
+			result = append(result, advisory)
+		}
+	}
+	return result
 }
 
 type Repository struct {
@@ -19,6 +72,18 @@ type Repository struct {
 	Issues       []Issue
 	Commits      []Commit
 	Releases     []Release
+	PullRequests []PullRequest
+
+	// OldestOpenPullRequest is looked up separately, PullRequests only holds the most recent ones
+	OldestOpenPullRequest *PullRequest
+
+	CI *CI
+
+	// PullRequestIssues are the pull requests listed by the issues endpoint, kept apart from real issues
+	PullRequestIssues []Issue
+
+	// Subpath is the directory of the dependency within a monorepo, commits, releases and contributors are scoped to it
+	Subpath string
 
 	*RepositoryData
 }
@@ -31,6 +96,67 @@ func (r *Repository) TotalIssues() int {
 	return len(r.Issues)
 }
 
+func (r *Repository) SelectCommits(bots bool) []Commit {
+	if bots {
+		return r.Commits
+	}
+
+	var result []Commit
+	for _, commit := range r.Commits {
+		if !commit.Bot {
+			result = append(result, commit)
+		}
+	}
+	return result
+}
+
+func (r *Repository) SelectPullRequests(bots bool) []PullRequest {
+	if bots {
+		return r.PullRequests
+	}
+
+	var result []PullRequest
+	for _, pr := range r.PullRequests {
+		if !pr.Bot {
+			result = append(result, pr)
+		}
+	}
+	return result
+}
+
+func (r *Repository) SelectContributors(bots bool) []Contributor {
+	if bots {
+		return r.Contributors
+	}
+
+	var result []Contributor
+	for _, contributor := range r.Contributors {
+		if !contributor.Bot {
+			result = append(result, contributor)
+		}
+	}
+	return result
+}
+
+// SelectIssues returns the real issues, optionally together with pull requests and with issues opened by bots
+func (r *Repository) SelectIssues(pullRequests, bots bool) []Issue {
+
+	population := r.Issues
+	if pullRequests {
+		population = append(append([]Issue{}, r.Issues...), r.PullRequestIssues...)
+	}
+
+	var result []Issue
+	for _, issue := range population {
+		if issue.Bot && !bots {
+			continue
+		}
+		result = append(result, issue)
+	}
+
+	return result
+}
+
 func (r *Repository) TotalReleases() int {
 	return len(r.Releases)
 }
@@ -42,10 +168,14 @@ func (r *Repository) TotalContributors() int {
 type RepositoryData struct {
 	Name      string
 	Owner     string
+	FullName  string
+	MovedTo   string
 	Org       *Organization
 	CreatedAt time.Time
 	Size      int
 
+	DefaultBranch string
+
 	License      string
 	AllowForking bool
 
@@ -60,8 +190,56 @@ type RepositoryData struct {
 	Watchers int
 	Stars    int
 
+	Fork       bool
+	ForkOf     string
+	ForkSource string
+
 	Dependencies []string
 	Dependents   []string
+
+	// SecurityPolicy is set for a SECURITY.md or an organisation wide policy, the pointers stay nil when not visible to the token
+	SecurityPolicy                bool
+	SecurityPolicyURL             string
+	PrivateVulnerabilityReporting *bool
+	VulnerabilityAlerts           *bool
+
+	Funding *Funding
+}
+
+type FundingSource string
+
+const (
+	FundingFile     FundingSource = "FUNDING.yml"
+	PackageMetadata FundingSource = "package metadata"
+)
+
+type FundingLink struct {
+	Platform string
+	URL      string
+	Source   FundingSource
+}
+
+// Funding is the project's own funding, sponsors of individual contributors are kept with the contributors
+type Funding struct {
+	Links           []FundingLink
+	SponsorsListing bool
+	Sponsors        int
+}
+
+// CI describes the build setup and the outcome of recent github actions runs on the default branch
+type CI struct {
+	Systems   []string
+	Workflows int
+
+	// Tests are the test directories and files found next to the build files and sources, TestsExpected tells whether
+	// the layout is plain enough that finding none means there are none
+	Tests         []string
+	TestsExpected bool
+
+	Runs          int
+	LastRun       *time.Time
+	LastSuccess   *time.Time
+	FailureStreak int
 }
 
 type Organization struct {
@@ -72,10 +250,16 @@ type Organization struct {
 	TotalPrivateRepos int
 	OwnedPrivateRepos int
 	Collaborators     int
+
+	// Verified organisations proved ownership of their domain, which usually means a company stands behind them
+	Verified bool
+	Company  string
 }
 
 type Commit struct {
+	SHA          string
 	Author       string
+	AuthorName   string
 	Committer    string
 	ChangedFiles []string
 	Message      string
@@ -83,6 +267,7 @@ type Commit struct {
 	Additions    int
 	Deletions    int
 	Total        int
+	Bot          bool
 }
 
 func (c Commit) GetTimestamp() time.Time {
@@ -91,6 +276,7 @@ func (c Commit) GetTimestamp() time.Time {
 
 type Release struct {
 	Author      string
+	Tag         string
 	Version     string
 	Description string
 	Date        time.Time
@@ -107,7 +293,19 @@ const (
 )
 
 type IssueContribution struct {
-	Time time.Time
+	Time              time.Time
+	Author            string
+	AuthorAssociation string
+	Bot               bool
+}
+
+// IsMaintainerAssociation tells whether a github author association grants write access to the repository
+func IsMaintainerAssociation(association string) bool {
+	switch strings.ToUpper(association) {
+	case "OWNER", "MEMBER", "COLLABORATOR":
+		return true
+	}
+	return false
 }
 
 func (ic IssueContribution) GetTimestamp() time.Time {
@@ -122,18 +320,78 @@ type Issue struct {
 	Title             string
 	Content           string
 	ClosedBy          string
+	Comments          int // all comments, Contributions only holds the first ones
 	Contributions     []IssueContribution
 	Contributors      []string
 	CreationTime      time.Time
 	FirstResponse     *time.Time
-	LastUpdate        time.Time
-	ClosingTime       time.Time
+	// FirstMaintainerResponse is the first comment of someone with write access other than the author
+	FirstMaintainerResponse *time.Time
+	LastUpdate              time.Time
+	ClosingTime             time.Time
+	Bot                     bool
 }
 
 func (i Issue) GetTimestamp() time.Time {
 	return i.CreationTime
 }
 
+// UpdateResponses derives the first responses from the contributions, which are in chronological order
+func (i *Issue) UpdateResponses() {
+
+	i.FirstResponse = nil
+	i.FirstMaintainerResponse = nil
+
+	for _, contribution := range i.Contributions {
+
+		if contribution.Bot || contribution.Author == i.Author {
+			continue
+		}
+
+		createdAt := contribution.Time
+
+		if i.FirstResponse == nil {
+			i.FirstResponse = &createdAt
+		}
+
+		if i.FirstMaintainerResponse == nil && IsMaintainerAssociation(contribution.AuthorAssociation) {
+			i.FirstMaintainerResponse = &createdAt
+			return
+		}
+	}
+}
+
+type PullRequestState string
+
+const (
+	PullRequestOpen   PullRequestState = "OPEN"
+	PullRequestClosed PullRequestState = "CLOSED"
+	PullRequestMerged PullRequestState = "MERGED"
+)
+
+type PullRequest struct {
+	Number            int
+	Author            string
+	AuthorAssociation string
+	State             PullRequestState
+	CreationTime      time.Time
+	MergeTime         *time.Time
+	ClosingTime       *time.Time
+	Reviews           int
+	FirstReview       *time.Time
+	Comments          int
+	Bot               bool
+}
+
+func (pr PullRequest) GetTimestamp() time.Time {
+	return pr.CreationTime
+}
+
+// IsExternal tells whether the pull request was opened by someone without write access
+func (pr PullRequest) IsExternal() bool {
+	return !IsMaintainerAssociation(pr.AuthorAssociation)
+}
+
 type Contributor struct {
 	Name              string
 	Company           string
@@ -143,6 +401,7 @@ type Contributor struct {
 	Repositories      int
 	FirstContribution *time.Time
 	LastContribution  *time.Time
+	Bot               bool
 }
 
 type ContributorInfo struct {
@@ -168,16 +427,64 @@ type Artifact struct {
 	Version              string
 	Description          string
 	ArtifactRepositories []string
-	Date                 time.Time
+	Date                 time.Time // zero if the publication date is unknown
 	Vulnerabilities      []string
 	Dependents           []string
 	Dependencies         []string
 	DeprecationWarning   bool // TODO: Cant interpret false confidently
+	DeprecationMessage   string
+	Relocation           *Relocation
 	Contributors         []string
 	Developers           []string
 	Organization         string
+	FundingLinks         []string
 	Licenses             []string
 	MailingLists         []string
+	SCM                  *SCM
+	Parents              []string
+}
+
+type SCM struct {
+	URL                 string
+	Connection          string
+	DeveloperConnection string
+	Tag                 string
+}
+
+type Relocation struct {
+	GroupID    string
+	ArtifactID string
+	Version    string
+	Message    string
+}
+
+func (r Relocation) Coordinates() string {
+	if r.Version == "" {
+		return fmt.Sprintf("%s:%s", r.GroupID, r.ArtifactID)
+	}
+	return fmt.Sprintf("%s:%s:%s", r.GroupID, r.ArtifactID, r.Version)
+}
+
+type LineSupport struct {
+	Line              string
+	LatestInLine      string
+	LastReleaseInLine time.Time
+	LatestLine        string
+	NewerLines        int
+	Backports         int
+	EndOfLine         bool
+}
+
+// Lifecycle is the published support schedule of the release cycle the dependency belongs to
+type Lifecycle struct {
+	Product        string
+	Cycle          string
+	LatestInCycle  string
+	LTS            bool
+	EndOfLife      bool
+	EndOfLifeDate  *time.Time
+	EndOfSupport   bool
+	SupportedUntil *time.Time
 }
 
 type Library struct {
@@ -185,6 +492,7 @@ type Library struct {
 	Licenses      []string
 	UsedBy        *int
 	Versions      []string
+	Releases      []Release
 	LastUpdated   time.Time
 	LatestVersion string
 	LatestRelease string
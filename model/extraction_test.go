@@ -0,0 +1,85 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestSelectIssues(t *testing.T) {
+
+	repository := &Repository{
+		Issues:            []Issue{{Number: 1}, {Number: 2, Bot: true}},
+		PullRequestIssues: []Issue{{Number: 3}, {Number: 4, Bot: true}},
+	}
+
+	numbers := func(issues []Issue) []int {
+		var result []int
+		for _, issue := range issues {
+			result = append(result, issue.Number)
+		}
+		return result
+	}
+
+	assert.Equal(t, []int{1}, numbers(repository.SelectIssues(false, false)))
+	assert.Equal(t, []int{1, 2}, numbers(repository.SelectIssues(false, true)))
+	assert.Equal(t, []int{1, 3}, numbers(repository.SelectIssues(true, false)))
+	assert.Equal(t, []int{1, 2, 3, 4}, numbers(repository.SelectIssues(true, true)))
+	assert.Len(t, repository.Issues, 2)
+}
+
+func TestSelectPullRequests(t *testing.T) {
+
+	repository := &Repository{PullRequests: []PullRequest{{Number: 1}, {Number: 2, Bot: true}}}
+
+	assert.Len(t, repository.SelectPullRequests(true), 2)
+	assert.Equal(t, []PullRequest{{Number: 1}}, repository.SelectPullRequests(false))
+}
+
+func TestUnreportedAdvisories(t *testing.T) {
+
+	index := &VulnerabilityIndex{
+		CVEs: []string{"CVE-2021-44228"},
+		Advisories: []Advisory{
+			{ID: "GHSA-1", CVEs: []string{"cve-2021-44228"}, Affected: true},
+			{ID: "GHSA-2", CVEs: []string{"CVE-2021-45046"}, Affected: true},
+			{ID: "GHSA-3", Affected: true},
+			{ID: "GHSA-4", Affected: false},
+		},
+	}
+
+	var ids []string
+	for _, advisory := range index.UnreportedAdvisories() {
+		ids = append(ids, advisory.ID)
+	}
+
+	assert.Equal(t, []string{"GHSA-2", "GHSA-3"}, ids)
+}
+
+func TestUpdateResponses(t *testing.T) {
+
+	day := func(d int) time.Time { return time.Date(2022, 1, d, 0, 0, 0, 0, time.UTC) }
+
+	issue := Issue{
+		Author: "reporter",
+		Contributions: []IssueContribution{
+			{Time: day(1), Author: "reporter", AuthorAssociation: "NONE"},
+			{Time: day(2), Author: "dependabot[bot]", AuthorAssociation: "NONE", Bot: true},
+			{Time: day(3), Author: "user", AuthorAssociation: "CONTRIBUTOR"},
+			{Time: day(4), Author: "maintainer", AuthorAssociation: "member"},
+			{Time: day(5), Author: "owner", AuthorAssociation: "OWNER"},
+		},
+	}
+
+	issue.UpdateResponses()
+
+	assert.Equal(t, day(3), *issue.FirstResponse)
+	assert.Equal(t, day(4), *issue.FirstMaintainerResponse)
+
+	// responses are derived again from scratch, without contributions there are none
+	issue.Contributions = issue.Contributions[:2]
+	issue.UpdateResponses()
+
+	assert.Nil(t, issue.FirstResponse)
+	assert.Nil(t, issue.FirstMaintainerResponse)
+}
@@ -0,0 +1,62 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+type Verdict string
+
+const (
+	Deprecated Verdict = "deprecated"
+	Banned     Verdict = "banned"
+	Replaced   Verdict = "replaced"
+	Watched    Verdict = "watch"
+	Approved   Verdict = "approved"
+)
+
+func (v Verdict) IsKnown() bool {
+	switch v {
+	case Deprecated, Banned, Replaced, Watched, Approved:
+		return true
+	}
+	return false
+}
+
+func (v Verdict) Recommendation() Recommendation {
+	switch v {
+	case Approved:
+		return NoConcerns
+	case Watched:
+		return Watchlist
+	default:
+		return DecisionMaking
+	}
+}
+
+// Knowledge is a curated verdict about a dependency, maintained outside deprec
+type Knowledge struct {
+	Pattern   string
+	Versions  string
+	Verdict   Verdict
+	Reason    string
+	Successor string
+	Expires   *time.Time
+	Override  bool
+	Source    string
+}
+
+func (k *Knowledge) DecisionReason() string {
+
+	reason := fmt.Sprintf("knowledge base (%s): %s", k.Source, k.Verdict)
+
+	if k.Reason != "" {
+		reason += " - " + k.Reason
+	}
+
+	if k.Successor != "" {
+		reason += fmt.Sprintf(", use '%s' instead", k.Successor)
+	}
+
+	return reason
+}
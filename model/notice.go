@@ -0,0 +1,32 @@
+package model
+
+type NoticeSource string
+
+const (
+	ReadMeNotice      NoticeSource = "readme"
+	AboutNotice       NoticeSource = "about"
+	DescriptionNotice NoticeSource = "artifact description"
+)
+
+type NoticeLocation string
+
+const (
+	TopNotice        NoticeLocation = "top"
+	BadgeNotice      NoticeLocation = "badge"
+	AdmonitionNotice NoticeLocation = "admonition"
+	BodyNotice       NoticeLocation = "body"
+)
+
+// DeprecationNotice is a phrase declaring the project deprecated, Snippet is the line it was found in
+type DeprecationNotice struct {
+	Source   NoticeSource
+	Location NoticeLocation
+	Language string
+	Phrase   string
+	Snippet  string
+}
+
+// Prominent notices are placed where maintainers announce the state of a project, not somewhere in the body text
+func (n DeprecationNotice) Prominent() bool {
+	return n.Location != BodyNotice
+}
@@ -0,0 +1,82 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+type PackageURL struct {
+	Type       string
+	Namespace  string
+	Name       string
+	Version    string
+	Qualifiers map[string]string
+	Subpath    string
+}
+
+func ParsePackageURL(purl string) (*PackageURL, error) {
+
+	if !strings.HasPrefix(purl, "pkg:") {
+		return nil, fmt.Errorf("package url '%s' does not start with 'pkg:'", purl)
+	}
+
+	remainder := strings.TrimPrefix(purl, "pkg:")
+
+	result := &PackageURL{Qualifiers: make(map[string]string)}
+
+	if i := strings.Index(remainder, "#"); i >= 0 {
+		result.Subpath = strings.Trim(remainder[i+1:], "/")
+		remainder = remainder[:i]
+	}
+
+	if i := strings.Index(remainder, "?"); i >= 0 {
+		for _, qualifier := range strings.Split(remainder[i+1:], "&") {
+			key, value, _ := strings.Cut(qualifier, "=")
+			value, err := url.QueryUnescape(value)
+			if err != nil {
+				return nil, fmt.Errorf("could not unescape qualifier '%s' of package url '%s': %s", key, purl, err)
+			}
+			result.Qualifiers[strings.ToLower(key)] = value
+		}
+		remainder = remainder[:i]
+	}
+
+	remainder = strings.Trim(remainder, "/")
+
+	if i := strings.LastIndex(remainder, "@"); i > strings.LastIndex(remainder, "/") {
+		version, err := url.PathUnescape(remainder[i+1:])
+		if err != nil {
+			return nil, fmt.Errorf("could not unescape version of package url '%s': %s", purl, err)
+		}
+		result.Version = version
+		remainder = remainder[:i]
+	}
+
+	segments := strings.Split(remainder, "/")
+	if len(segments) < 2 {
+		return nil, errors.New("package url is missing type or name")
+	}
+
+	for i, segment := range segments {
+		unescaped, err := url.PathUnescape(segment)
+		if err != nil {
+			return nil, fmt.Errorf("could not unescape segment '%s' of package url '%s': %s", segment, purl, err)
+		}
+		segments[i] = unescaped
+	}
+
+	result.Type = strings.ToLower(segments[0])
+	result.Name = segments[len(segments)-1]
+	result.Namespace = strings.Join(segments[1:len(segments)-1], "/")
+
+	return result, nil
+}
+
+func (p *PackageURL) FullName() string {
+	if p.Namespace == "" {
+		return p.Name
+	}
+	return p.Namespace + "/" + p.Name
+}
@@ -0,0 +1,34 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParsePackageURLMaven(t *testing.T) {
+
+	purl, err := ParsePackageURL("pkg:maven/org.apache.logging.log4j/log4j-core@2.17.1?type=jar")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "maven", purl.Type)
+	assert.Equal(t, "org.apache.logging.log4j", purl.Namespace)
+	assert.Equal(t, "log4j-core", purl.Name)
+	assert.Equal(t, "2.17.1", purl.Version)
+	assert.Equal(t, "jar", purl.Qualifiers["type"])
+}
+
+func TestParsePackageURLScopedNPM(t *testing.T) {
+
+	purl, err := ParsePackageURL("pkg:npm/%40angular/core@15.0.1")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "@angular/core", purl.FullName())
+	assert.Equal(t, "15.0.1", purl.Version)
+}
+
+func TestParsePackageURLInvalid(t *testing.T) {
+
+	_, err := ParsePackageURL("maven/log4j")
+
+	assert.NotNil(t, err)
+}
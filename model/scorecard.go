@@ -0,0 +1,35 @@
+package model
+
+import "time"
+
+const (
+	ScorecardMaintained       = "Maintained"
+	ScorecardCodeReview       = "Code-Review"
+	ScorecardCITests          = "CI-Tests"
+	ScorecardSecurityPolicy   = "Security-Policy"
+	ScorecardBranchProtection = "Branch-Protection"
+)
+
+type ScorecardCheck struct {
+	Name   string
+	Score  int
+	Reason string
+}
+
+// Scorecard holds the OpenSSF Scorecard checks of the repository, scores range from 0 to 10
+type Scorecard struct {
+	Date   time.Time
+	Commit string
+	Score  float64
+	Checks []ScorecardCheck
+}
+
+// Check returns the score of the named check, inconclusive checks scored with -1 count as missing
+func (s *Scorecard) Check(name string) (int, bool) {
+	for _, check := range s.Checks {
+		if check.Name == name {
+			return check.Score, check.Score >= 0
+		}
+	}
+	return 0, false
+}
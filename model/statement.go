@@ -0,0 +1,80 @@
+package model
+
+import "fmt"
+
+const (
+	GitHubSource        = "github"
+	ScorecardSource     = "scorecard"
+	MavenCentralSource  = "mavencentral"
+	NPMSource           = "npm"
+	RegistrySource      = "registry"
+	OSSIndexSource      = "ossindex"
+	AdvisorySource      = "advisories"
+	EndOfLifeSource     = "endoflife"
+	KnowledgeBaseSource = "knowledgebase"
+	DependencySource    = "dependency"
+)
+
+// Statement is a single intake of a core, kept so that a recommendation can be traced back to the data behind it
+type Statement struct {
+	Core       CoreName
+	Metric     string
+	Value      float64
+	Limit      *float64
+	Threshold  *float64
+	Normalized float64
+	Bucket     Recommendation
+	Weight     float64
+	Source     string
+	Evidence   string
+}
+
+func (s Statement) String() string {
+
+	description := fmt.Sprintf("%s: %s = %.2f", s.Core, s.Metric, s.Value)
+
+	if s.Limit != nil {
+		description += fmt.Sprintf(" (limit %.2f)", *s.Limit)
+	}
+	if s.Threshold != nil {
+		description += fmt.Sprintf(" (threshold %.2f)", *s.Threshold)
+	}
+
+	description += fmt.Sprintf(" -> %s x %.2f", s.Bucket, s.Weight)
+
+	if s.Evidence != "" {
+		description += fmt.Sprintf(" [%s]", s.Evidence)
+	}
+
+	return description
+}
+
+// Measurement names the metric and source of the next intake, e.g. cr.Measure("Stars", GitHubSource).IntakeThreshold(stars, 1000, w)
+type Measurement struct {
+	core      *Core
+	statement Statement
+}
+
+func (cr *Core) Measure(metric string, source string) *Measurement {
+	return &Measurement{core: cr, statement: Statement{Metric: metric, Source: source}}
+}
+
+// Because attaches the data the statement is based on
+func (m *Measurement) Because(format string, args ...any) *Measurement {
+	m.statement.Evidence = fmt.Sprintf(format, args...)
+	return m
+}
+
+func (m *Measurement) Intake(value float64, weight float64) {
+	s := m.statement
+	s.Value = value
+	m.core.take(s, value, weight)
+}
+
+func (m *Measurement) IntakeLimit(value, limit, weight float64) {
+	m.core.intakeLimit(m.statement, value, limit, weight)
+}
+
+func (m *Measurement) IntakeThreshold(value, threshold, weight float64) {
+	m.core.intakeThreshold(m.statement, value, threshold, weight)
+}
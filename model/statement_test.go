@@ -0,0 +1,68 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestMeasureRecordsStatement(t *testing.T) {
+
+	cr := NewCore(Recentness)
+
+	cr.Measure("Months since last release", GitHubSource).Because("release '1.0'").IntakeLimit(12, 24, 2)
+
+	assert.Equal(t, 2.0, cr.NoImmediateAction)
+	assert.Len(t, cr.Statements, 1)
+
+	statement := cr.Statements[0]
+	assert.Equal(t, Recentness, statement.Core)
+	assert.Equal(t, "Months since last release", statement.Metric)
+	assert.Equal(t, 12.0, statement.Value)
+	assert.Equal(t, 24.0, *statement.Limit)
+	assert.Nil(t, statement.Threshold)
+	assert.Equal(t, 0.5, statement.Normalized)
+	assert.Equal(t, NoImmediateAction, statement.Bucket)
+	assert.Equal(t, 2.0, statement.Weight)
+	assert.Equal(t, GitHubSource, statement.Source)
+	assert.Equal(t, "release '1.0'", statement.Evidence)
+}
+
+func TestIntakeWithoutWeightOrBucketLeavesNoStatement(t *testing.T) {
+
+	cr := NewCore(Popularity)
+
+	cr.IntakeThreshold(50, 100, 0)
+	cr.Intake(2, 1)
+	cr.Intake(-1, 1)
+
+	assert.Empty(t, cr.Statements)
+
+	cr.IntakeThreshold(50, 100, 1)
+
+	assert.Len(t, cr.Statements, 1)
+	assert.Equal(t, 100.0, *cr.Statements[0].Threshold)
+	assert.Equal(t, "", cr.Statements[0].Metric)
+}
+
+func TestAllStatementsWalksUnderlyingCores(t *testing.T) {
+
+	activity := NewCore(Activity)
+	activity.Measure("Commits", GitHubSource).Intake(NC, 1)
+
+	recentness := NewCore(Recentness)
+	recentness.Measure("Months since last commit", GitHubSource).IntakeLimit(30, 24, 1)
+
+	effort := NewCore(Effort)
+	effort.Overtake(*activity, 1)
+	effort.Overtake(*recentness, 4)
+
+	combcon := NewCore(CombCon)
+	combcon.Overtake(*effort, 1)
+
+	statements := combcon.AllStatements()
+
+	assert.Len(t, statements, 2)
+	assert.Equal(t, Recentness, statements[0].Core)
+	assert.Equal(t, DecisionMaking, statements[0].Bucket)
+	assert.Equal(t, Activity, statements[1].Core)
+}
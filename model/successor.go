@@ -0,0 +1,22 @@
+package model
+
+type SuccessorSource string
+
+const (
+	MavenRelocation    SuccessorSource = "maven-relocation"
+	RegistryDeprecated SuccessorSource = "registry-deprecation"
+	RepositoryRedirect SuccessorSource = "repository-redirect"
+	ReadMeMention      SuccessorSource = "readme"
+	KnowledgeBaseEntry SuccessorSource = "knowledge-base"
+)
+
+type SuccessorEvidence struct {
+	Source  SuccessorSource
+	Snippet string
+}
+
+type Successor struct {
+	Name     string
+	Score    float64
+	Evidence []SuccessorEvidence
+}
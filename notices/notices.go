@@ -0,0 +1,338 @@
+package notices
+
+import (
+	"github.com/a-grasso/deprec/configuration"
+	"github.com/a-grasso/deprec/logging"
+	"github.com/a-grasso/deprec/model"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const defaultTopLines = 30
+
+const maxSnippetLength = 200
+
+// defaultPhrases are phrases per language a project uses to declare itself deprecated
+var defaultPhrases = map[string][]string{
+	"en": {
+		"deprecated", "no longer maintained", "not maintained anymore", "not actively maintained", "unmaintained",
+		"no longer supported", "abandoned", "end-of-life", "end of life", "this project is dead",
+		"no longer under development", "no longer being developed",
+	},
+	"de": {"veraltet", "nicht mehr gepflegt", "nicht mehr gewartet", "wird nicht mehr weiterentwickelt"},
+	"fr": {"obsolète", "déprécié", "n'est plus maintenu", "n'est plus supporté", "abandonné"},
+	"es": {"obsoleto", "ya no se mantiene", "sin mantenimiento", "abandonado"},
+	"pt": {"descontinuado", "obsoleto", "não é mais mantido", "abandonado"},
+	"zh": {"已弃用", "已废弃", "不再维护", "停止维护"},
+	"ja": {"非推奨", "メンテナンスされていません", "開発終了"},
+}
+
+// badgePatterns match the markup of status badges, e.g. shields.io and repostatus.org badges
+var badgePatterns = []string{
+	`maintained(%3F|\?)?-no`,
+	`maintenance-no`,
+	`no(%20|-|_)maintenance`,
+	`project(%20|-|_)status-(unsupported|inactive|abandoned|moved|deprecated)`,
+	`repostatus\.org/badges/[^/]+/(unsupported|inactive|abandoned|moved)`,
+	`status-(deprecated|unmaintained|abandoned|eol)`,
+	`badge/(deprecated|unmaintained|abandoned)`,
+}
+
+// negations in front of a phrase turn it around, e.g. 'not deprecated'
+var negation = regexp.MustCompile(`(?i)(?:^|[^\p{L}])(?:not|never|no|non|isn't|isnt|aren't|wasn't|nicht|kein|keine|pas|nunca|jamais)(?:\s+[\p{L}']+){0,2}\s*$`)
+
+// objects following a phrase show it describes a part of the project or another project, e.g. 'deprecated API removed'.
+// Only whitespace, '-' or '/' may separate them, the end of a sentence or clause such as '.', ';' or ':' ends the phrase
+var object = regexp.MustCompile(`(?i)^[\s/-]*(?:apis?|methods?|functions?|options?|flags?|parameters?|params?|endpoints?|features?|class(?:es)?|fields?|syntax|configs?|configuration|propert(?:y|ies)|modules?|calls?|usages?|interfaces?|arguments?|settings?|fork|forks|dependency|dependencies|upstream|plugins?|versions?|branch(?:es)?|warnings?)(?:[^\p{L}]|$)`)
+
+// skippedSections match the whole heading text, so a project named 'history' or a heading mentioning changes are still read
+var skippedSections = regexp.MustCompile(`(?i)^(?:change ?log|changes|(?:release |version )?history|release notes|what['’]s new|migration(?: guide)?|upgrading(?: guide)?)$`)
+
+// headingMarkup is stripped from both ends of a heading text, e.g. closing hashes, emphasis, emoji shortcodes and colons
+var headingMarkup = regexp.MustCompile(`^(?::[a-z0-9_+-]+:|[^\p{L}\p{N}])+|[^\p{L}\p{N}]+$`)
+
+var heading = regexp.MustCompile(`^\s{0,3}(#{1,6})\s+(.*)$`)
+
+var image = regexp.MustCompile(`!\[[^\]]*\]\([^)]*\)|<img\s[^>]*>`)
+
+var fence = regexp.MustCompile("^\\s*(```|~~~)")
+
+var comment = regexp.MustCompile(`(?s)<!--.*?-->`)
+
+var admonitionStart = regexp.MustCompile(`(?i)^\s*(?:!!!|\?\?\?\+?|\.\.\s+(?:warning|deprecated|caution|danger|important|attention|note)::)`)
+
+// literal phrases capture the phrase itself in their first group, the boundaries around it are not part of the notice
+type phrase struct {
+	language string
+	pattern  *regexp.Regexp
+	literal  bool
+}
+
+// Detector finds deprecation notices in the readme, the repository description and the artifact description
+type Detector struct {
+	phrases  []phrase
+	keywords map[model.NoticeSource][]phrase
+	badges   []*regexp.Regexp
+	topLines int
+}
+
+// NewDetector extends the default phrases with the configured phrases and patterns, invalid patterns are skipped
+func NewDetector(config configuration.Marking) *Detector {
+
+	detector := &Detector{
+		keywords: make(map[model.NoticeSource][]phrase),
+		topLines: config.ReadMeTopLines,
+	}
+
+	if detector.topLines <= 0 {
+		detector.topLines = defaultTopLines
+	}
+
+	for language, phrases := range defaultPhrases {
+		detector.phrases = append(detector.phrases, literals(language, phrases)...)
+	}
+
+	for language, phrases := range config.NoticePhrases {
+		detector.phrases = append(detector.phrases, literals(language, phrases)...)
+	}
+
+	for _, pattern := range config.NoticePatterns {
+		if compiled := compile(pattern); compiled != nil {
+			detector.phrases = append(detector.phrases, phrase{language: "pattern", pattern: compiled})
+		}
+	}
+
+	detector.keywords[model.ReadMeNotice] = literals("keyword", config.ReadMeKeywords)
+	detector.keywords[model.AboutNotice] = literals("keyword", config.AboutKeywords)
+	detector.keywords[model.DescriptionNotice] = literals("keyword", config.ArtifactDescriptionKeywords)
+
+	for _, pattern := range badgePatterns {
+		detector.badges = append(detector.badges, compile(pattern))
+	}
+
+	return detector
+}
+
+func literals(language string, phrases []string) []phrase {
+
+	var result []phrase
+
+	for _, p := range phrases {
+		if strings.TrimSpace(p) == "" {
+			continue
+		}
+		result = append(result, phrase{language: language, pattern: regexp.MustCompile("(?i)" + bounded(p)), literal: true})
+	}
+
+	return result
+}
+
+// bounded adds word boundaries to phrases starting or ending with a latin letter, scripts without spaces match as is
+func bounded(p string) string {
+
+	quoted := regexp.QuoteMeta(p)
+
+	first, _ := utf8.DecodeRuneInString(p)
+	if isLetter(first) {
+		quoted = `(?:^|[^\p{L}])` + "(" + quoted + ")"
+	} else {
+		quoted = "(" + quoted + ")"
+	}
+
+	last, _ := utf8.DecodeLastRuneInString(p)
+	if isLetter(last) {
+		quoted += `(?:[^\p{L}]|$)`
+	}
+
+	return quoted
+}
+
+func isLetter(r rune) bool {
+	return unicode.IsLetter(r) && r < unicode.MaxLatin1
+}
+
+func compile(pattern string) *regexp.Regexp {
+	compiled, err := regexp.Compile("(?i)" + pattern)
+	if err != nil {
+		logging.SugaredLogger.Warnf("skipping invalid notice pattern '%s' : %s", pattern, err)
+		return nil
+	}
+	return compiled
+}
+
+// Detect collects the notices of every text source of the data model
+func (d *Detector) Detect(m model.DataModel) []model.DeprecationNotice {
+
+	var result []model.DeprecationNotice
+
+	if m.Repository != nil && m.Repository.RepositoryData != nil {
+		result = append(result, d.DetectReadMe(m.Repository.ReadMe)...)
+		result = append(result, d.DetectText(model.AboutNotice, m.Repository.About)...)
+	}
+
+	if m.Distribution != nil && m.Distribution.Artifact != nil {
+		result = append(result, d.DetectText(model.DescriptionNotice, m.Distribution.Artifact.Description)...)
+	}
+
+	return result
+}
+
+// DetectText handles short texts like descriptions, which are as prominent as the top of a readme
+func (d *Detector) DetectText(source model.NoticeSource, text string) []model.DeprecationNotice {
+
+	var notices []model.DeprecationNotice
+
+	for _, line := range strings.Split(text, "\n") {
+		notices = append(notices, d.detectLine(source, line, model.TopNotice)...)
+	}
+
+	return deduplicate(notices)
+}
+
+// DetectReadMe skips code blocks and changelog sections, the location of a notice tells how prominent it is
+func (d *Detector) DetectReadMe(readme string) []model.DeprecationNotice {
+
+	var notices []model.DeprecationNotice
+
+	readme = comment.ReplaceAllString(readme, "")
+
+	inFence, inSkippedSection, inAdmonition := false, false, false
+	skippedLevel, headings := 0, 0
+
+	for i, line := range strings.Split(readme, "\n") {
+
+		if fence.MatchString(line) {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+
+		if match := heading.FindStringSubmatch(line); match != nil {
+			level := len(match[1])
+			if inSkippedSection && level > skippedLevel {
+				continue
+			}
+			// the title names the project, it never starts a changelog
+			headings++
+			inSkippedSection = headings > 1 && level > 1 && skippedSections.MatchString(headingMarkup.ReplaceAllString(match[2], ""))
+			skippedLevel = level
+			inAdmonition = false
+		}
+		if inSkippedSection {
+			continue
+		}
+
+		// admonition bodies are indented below their marker
+		if admonitionStart.MatchString(line) {
+			inAdmonition = true
+		} else if inAdmonition && strings.TrimSpace(line) != "" && !strings.HasPrefix(line, " ") && !strings.HasPrefix(line, "\t") {
+			inAdmonition = false
+		}
+
+		location := model.BodyNotice
+		if i < d.topLines {
+			location = model.TopNotice
+		}
+		if inAdmonition || strings.HasPrefix(strings.TrimSpace(line), ">") {
+			location = model.AdmonitionNotice
+		}
+
+		notices = append(notices, d.detectBadges(line)...)
+		notices = append(notices, d.detectLine(model.ReadMeNotice, image.ReplaceAllString(line, " "), location)...)
+	}
+
+	return deduplicate(notices)
+}
+
+func (d *Detector) detectBadges(line string) []model.DeprecationNotice {
+
+	var result []model.DeprecationNotice
+
+	for _, badge := range image.FindAllString(line, -1) {
+		for _, pattern := range d.badges {
+			if match := pattern.FindString(badge); match != "" {
+				result = append(result, model.DeprecationNotice{
+					Source:   model.ReadMeNotice,
+					Location: model.BadgeNotice,
+					Language: "badge",
+					Phrase:   match,
+					Snippet:  snippet(badge),
+				})
+				break
+			}
+		}
+	}
+
+	return result
+}
+
+func (d *Detector) detectLine(source model.NoticeSource, line string, location model.NoticeLocation) []model.DeprecationNotice {
+
+	var result []model.DeprecationNotice
+
+	phrases := append(append([]phrase{}, d.phrases...), d.keywords[source]...)
+
+	for _, p := range phrases {
+		for _, match := range p.pattern.FindAllStringSubmatchIndex(line, -1) {
+
+			start, end := match[0], match[1]
+			if p.literal {
+				start, end = match[2], match[3]
+			}
+
+			if negation.MatchString(line[:start]) || object.MatchString(line[end:]) {
+				continue
+			}
+
+			result = append(result, model.DeprecationNotice{
+				Source:   source,
+				Location: location,
+				Language: p.language,
+				Phrase:   line[start:end],
+				Snippet:  snippet(line),
+			})
+		}
+	}
+
+	return result
+}
+
+// deduplicate keeps one notice per phrase, preferring the most prominent location
+func deduplicate(notices []model.DeprecationNotice) []model.DeprecationNotice {
+
+	var result []model.DeprecationNotice
+	index := make(map[string]int)
+
+	for _, notice := range notices {
+
+		key := string(notice.Source) + "|" + strings.ToLower(notice.Phrase)
+
+		i, exists := index[key]
+		if !exists {
+			index[key] = len(result)
+			result = append(result, notice)
+			continue
+		}
+
+		if !result[i].Prominent() && notice.Prominent() {
+			result[i] = notice
+		}
+	}
+
+	return result
+}
+
+func snippet(line string) string {
+
+	line = strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(line), ">"))
+
+	if utf8.RuneCountInString(line) > maxSnippetLength {
+		line = string([]rune(line)[:maxSnippetLength]) + "..."
+	}
+
+	return line
+}
@@ -0,0 +1,188 @@
+package notices
+
+import (
+	"github.com/a-grasso/deprec/configuration"
+	"github.com/a-grasso/deprec/model"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func phrases(notices []model.DeprecationNotice) []string {
+	var result []string
+	for _, notice := range notices {
+		result = append(result, strings.ToLower(notice.Phrase))
+	}
+	return result
+}
+
+func TestDetectReadMeTop(t *testing.T) {
+
+	detector := NewDetector(configuration.Marking{})
+
+	notices := detector.DetectReadMe("# left-pad\n\n**This package is deprecated, use String.prototype.padStart instead.**\n")
+
+	assert.Len(t, notices, 1)
+	assert.Equal(t, model.TopNotice, notices[0].Location)
+	assert.Equal(t, "deprecated", notices[0].Phrase)
+	assert.Equal(t, "en", notices[0].Language)
+	assert.Equal(t, "**This package is deprecated, use String.prototype.padStart instead.**", notices[0].Snippet)
+}
+
+func TestDetectReadMeNegationAndObjects(t *testing.T) {
+
+	detector := NewDetector(configuration.Marking{})
+
+	readme := strings.Join([]string{
+		"# lib",
+		"This library is not deprecated, it is just feature complete.",
+		"The deprecated API was removed in 3.0.",
+		"Replaces the unmaintained fork of the original parser.",
+		"It is never abandoned by us.",
+	}, "\n")
+
+	assert.Empty(t, detector.DetectReadMe(readme))
+}
+
+func TestDetectReadMeObjectsEndAtPunctuation(t *testing.T) {
+
+	detector := NewDetector(configuration.Marking{})
+
+	tests := []struct {
+		readme string
+		phrase string
+	}{
+		{"# lib\n\nThis project is deprecated. Upstream moved to example/new.", "deprecated"},
+		{"# lib\n\nThis library is no longer maintained; fork it if you need changes.", "no longer maintained"},
+		{"# lib\n\nUnmaintained. Versions after 2.0 live in bar.", "unmaintained"},
+		{"# lib\n\nSee the deprecated/options page for 1.x.", ""},
+		{"# lib\n\nThe deprecated - API was removed.", ""},
+	}
+
+	for _, test := range tests {
+		notices := detector.DetectReadMe(test.readme)
+		if test.phrase == "" {
+			assert.Empty(t, notices, test.readme)
+			continue
+		}
+		assert.Equal(t, []string{test.phrase}, phrases(notices), test.readme)
+	}
+}
+
+func TestDetectReadMeSkipsChangelogAndCode(t *testing.T) {
+
+	detector := NewDetector(configuration.Marking{})
+
+	readme := strings.Join([]string{
+		"# lib",
+		"```",
+		"// deprecated",
+		"```",
+		"<!-- this project is abandoned -->",
+		"## Changelog",
+		"### 2.0",
+		"- switched away from an abandoned library",
+		"## Usage",
+		"Call it.",
+	}, "\n")
+
+	assert.Empty(t, detector.DetectReadMe(readme))
+}
+
+func TestDetectReadMeSkipsOnlyWholeChangelogHeadings(t *testing.T) {
+
+	detector := NewDetector(configuration.Marking{})
+
+	tests := []struct {
+		readme  string
+		phrases []string
+	}{
+		{"# history\n\nThis project is deprecated.", []string{"deprecated"}},
+		{"# Exchanges\n\nThis package is no longer maintained.", []string{"no longer maintained"}},
+		{"Intro\n\n## History\n\nThis project is deprecated.", []string{"deprecated"}},
+		{"# lib\n\n## Exchanges\n\nThis package is no longer maintained.", []string{"no longer maintained"}},
+		{"# lib\n\n## Breaking changes in 2.0\n\nThis package is deprecated.", []string{"deprecated"}},
+		{"# lib\n\n## Release History\n\n- dropped the abandoned parser", nil},
+		{"# lib\n\n## :memo: Changelog:\n\n- dropped the abandoned parser", nil},
+		{"# lib\n\n## What’s new\n\n- dropped the abandoned parser", nil},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.phrases, phrases(detector.DetectReadMe(test.readme)), test.readme)
+	}
+}
+
+func TestDetectReadMeLocations(t *testing.T) {
+
+	detector := NewDetector(configuration.Marking{ReadMeTopLines: 2})
+
+	readme := strings.Join([]string{
+		"[![Maintenance](https://img.shields.io/badge/Maintained%3F-no-red.svg)](https://example.org)",
+		"# lib",
+		"## Status",
+		"> [!WARNING]",
+		"> This project is no longer maintained.",
+		"!!! note",
+		"    Consider it end-of-life.",
+		"Some body text mentions it is unmaintained.",
+	}, "\n")
+
+	notices := detector.DetectReadMe(readme)
+
+	locations := make(map[string]model.NoticeLocation)
+	for _, notice := range notices {
+		locations[strings.ToLower(notice.Phrase)] = notice.Location
+	}
+
+	assert.Equal(t, model.BadgeNotice, locations["maintained%3f-no"])
+	assert.Equal(t, model.AdmonitionNotice, locations["no longer maintained"])
+	assert.Equal(t, model.AdmonitionNotice, locations["end-of-life"])
+	assert.Equal(t, model.BodyNotice, locations["unmaintained"])
+}
+
+func TestDetectReadMeDeduplicates(t *testing.T) {
+
+	detector := NewDetector(configuration.Marking{ReadMeTopLines: 1, ReadMeKeywords: []string{"deprecated"}})
+
+	readme := strings.Join([]string{
+		"# lib",
+		"It is deprecated.",
+		"> Deprecated: use something else.",
+		"Still deprecated.",
+	}, "\n")
+
+	notices := detector.DetectReadMe(readme)
+
+	assert.Len(t, notices, 1)
+	assert.Equal(t, model.AdmonitionNotice, notices[0].Location)
+	assert.Equal(t, "Deprecated: use something else.", notices[0].Snippet)
+}
+
+func TestDetectTextLanguagesAndPatterns(t *testing.T) {
+
+	detector := NewDetector(configuration.Marking{
+		NoticePhrases:  map[string][]string{"nl": {"niet meer onderhouden"}},
+		NoticePatterns: []string{`superseded by \S+`, `(`},
+	})
+
+	assert.Equal(t, []string{"nicht mehr gepflegt"}, phrases(detector.DetectText(model.AboutNotice, "Dieses Projekt wird nicht mehr gepflegt.")))
+	assert.Equal(t, []string{"不再维护"}, phrases(detector.DetectText(model.AboutNotice, "本项目不再维护")))
+	assert.Equal(t, []string{"niet meer onderhouden"}, phrases(detector.DetectText(model.AboutNotice, "Wordt niet meer onderhouden")))
+	assert.Equal(t, []string{"superseded by jakarta.xml.bind"}, phrases(detector.DetectText(model.DescriptionNotice, "Superseded by jakarta.xml.bind")))
+	assert.Empty(t, detector.DetectText(model.AboutNotice, "Deprecation warnings for your codebase"))
+}
+
+func TestDetectUsesSourceKeywords(t *testing.T) {
+
+	detector := NewDetector(configuration.Marking{AboutKeywords: []string{"sunset"}})
+
+	m := model.DataModel{
+		Repository: &model.Repository{RepositoryData: &model.RepositoryData{About: "sunset project", ReadMe: "# sunset\nA library."}},
+	}
+
+	notices := detector.Detect(m)
+
+	assert.Len(t, notices, 1)
+	assert.Equal(t, model.AboutNotice, notices[0].Source)
+	assert.Equal(t, "keyword", notices[0].Language)
+}
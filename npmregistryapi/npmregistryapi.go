@@ -0,0 +1,169 @@
+package npmregistryapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/a-grasso/deprec/versioning"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+)
+
+type Client struct {
+	BaseURLPackage string
+	HTTPClient     *http.Client
+}
+
+func NewClient() *Client {
+	return &Client{
+		BaseURLPackage: "https://registry.npmjs.org/%s",
+		HTTPClient:     &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+type Package struct {
+	Name        string
+	Description string
+	Latest      string
+	Modified    time.Time
+	Versions    []Version
+}
+
+type Version struct {
+	Version     string
+	Description string
+	Deprecated  string
+	License     string
+	Funding     []string
+	Published   time.Time
+}
+
+type registryPackage struct {
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	DistTags    map[string]string `json:"dist-tags"`
+	Time        map[string]string `json:"time"`
+	Versions    map[string]struct {
+		Version     string          `json:"version"`
+		Description string          `json:"description"`
+		Deprecated  json.RawMessage `json:"deprecated"`
+		License     json.RawMessage `json:"license"`
+		Funding     json.RawMessage `json:"funding"`
+	} `json:"versions"`
+}
+
+func (c *Client) GetPackage(name string) (*Package, error) {
+
+	escaped := url.PathEscape(name)
+
+	resp, err := c.HTTPClient.Get(fmt.Sprintf(c.BaseURLPackage, escaped))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("npm registry responded with status %d for package '%s'", resp.StatusCode, name)
+	}
+
+	var p registryPackage
+	err = json.NewDecoder(resp.Body).Decode(&p)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Package{
+		Name:        p.Name,
+		Description: p.Description,
+		Latest:      p.DistTags["latest"],
+		Modified:    parseTime(p.Time["modified"]),
+	}
+
+	for version, v := range p.Versions {
+		result.Versions = append(result.Versions, Version{
+			Version:     version,
+			Description: v.Description,
+			Deprecated:  parseDeprecated(v.Deprecated),
+			License:     parseLicense(v.License),
+			Funding:     parseFunding(v.Funding),
+			Published:   parseTime(p.Time[version]),
+		})
+	}
+
+	// versions come from a map, order them oldest first
+	sort.Slice(result.Versions, func(i, j int) bool {
+		if c := versioning.Compare(result.Versions[i].Version, result.Versions[j].Version); c != 0 {
+			return c < 0
+		}
+		return result.Versions[i].Version < result.Versions[j].Version
+	})
+
+	return result, nil
+}
+
+func parseTime(value string) time.Time {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+// the registry keeps 'deprecated' as a message, but older packages use booleans
+func parseDeprecated(raw json.RawMessage) string {
+	var message string
+	if err := json.Unmarshal(raw, &message); err == nil {
+		return message
+	}
+
+	var deprecated bool
+	if err := json.Unmarshal(raw, &deprecated); err == nil && deprecated {
+		return "deprecated"
+	}
+
+	return ""
+}
+
+// licenses are either SPDX expressions or legacy objects like {"type": "MIT"}
+func parseLicense(raw json.RawMessage) string {
+	var license string
+	if err := json.Unmarshal(raw, &license); err == nil {
+		return license
+	}
+
+	var legacy struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(raw, &legacy); err == nil {
+		return legacy.Type
+	}
+
+	return ""
+}
+
+// funding is a url, an object like {"type": "opencollective", "url": "..."} or a list of both
+func parseFunding(raw json.RawMessage) []string {
+	var link string
+	if err := json.Unmarshal(raw, &link); err == nil {
+		return []string{link}
+	}
+
+	var object struct {
+		URL string `json:"url"`
+	}
+	if err := json.Unmarshal(raw, &object); err == nil && object.URL != "" {
+		return []string{object.URL}
+	}
+
+	var list []json.RawMessage
+	if err := json.Unmarshal(raw, &list); err != nil {
+		return nil
+	}
+
+	var result []string
+	for _, element := range list {
+		result = append(result, parseFunding(element)...)
+	}
+	return result
+}
@@ -0,0 +1,25 @@
+package npmregistryapi
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseFunding(t *testing.T) {
+
+	tests := []struct {
+		raw   string
+		links []string
+	}{
+		{`"https://github.com/sponsors/o"`, []string{"https://github.com/sponsors/o"}},
+		{`{"type": "opencollective", "url": "https://opencollective.com/p"}`, []string{"https://opencollective.com/p"}},
+		{`["https://github.com/sponsors/o", {"type": "patreon", "url": "https://patreon.com/p"}]`, []string{"https://github.com/sponsors/o", "https://patreon.com/p"}},
+		{`{"type": "individual"}`, nil},
+		{`42`, nil},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.links, parseFunding(json.RawMessage(test.raw)), test.raw)
+	}
+}
@@ -0,0 +1,30 @@
+package npmregistryapi
+
+import (
+	"context"
+	"github.com/a-grasso/deprec/cache"
+)
+
+type ClientWrapper struct {
+	Cache  *cache.Cache
+	Client *Client
+}
+
+func NewClientWrapper(client *Client, cache *cache.Cache) *ClientWrapper {
+	return &ClientWrapper{
+		Cache:  cache,
+		Client: client,
+	}
+}
+
+func (cw *ClientWrapper) GetPackage(name string) (*Package, error) {
+
+	coll := cw.Cache.Database("npmregistry_package").Collection(name)
+
+	f := func() (*Package, error) {
+		p, err := cw.Client.GetPackage(name)
+		return p, err
+	}
+
+	return cache.FetchSingle[Package](context.TODO(), coll, f)
+}
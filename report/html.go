@@ -0,0 +1,106 @@
+package report
+
+import (
+	_ "embed"
+	"fmt"
+	"github.com/a-grasso/deprec"
+	"github.com/a-grasso/deprec/model"
+	"html/template"
+	"io"
+	"sort"
+	"time"
+)
+
+//go:embed report.html.tmpl
+var htmlTemplate string
+
+var page = template.Must(template.New("report").Funcs(template.FuncMap{
+	"percent": percent,
+	"number":  number,
+	"class":   class,
+}).Parse(htmlTemplate))
+
+// order in which dependencies are listed, the most pressing recommendation first
+var ranks = map[model.Recommendation]int{
+	model.DecisionMaking:    0,
+	model.Watchlist:         1,
+	model.NoImmediateAction: 2,
+	model.NoConcerns:        3,
+	model.Inconclusive:      4,
+}
+
+type summary struct {
+	Recommendation string
+	Count          int
+}
+
+type dependency struct {
+	deprec.DependencyResult
+	Rank int
+}
+
+type htmlReport struct {
+	SchemaVersion string
+	GeneratedAt   string
+	Total         int
+	Summary       []summary
+	Dependencies  []dependency
+}
+
+// HTML writes a single self-contained page of the run, styles and scripts are inlined so the file can be passed around or printed
+func HTML(w io.Writer, result *deprec.Result, generatedAt time.Time) error {
+
+	document := deprec.NewResultDocument(result, generatedAt)
+
+	counts := make(map[string]int)
+
+	var dependencies []dependency
+	for _, r := range document.Results {
+		counts[r.TopRecommendation]++
+		dependencies = append(dependencies, dependency{DependencyResult: r, Rank: ranks[model.Recommendation(r.TopRecommendation)]})
+	}
+
+	sort.SliceStable(dependencies, func(i, j int) bool {
+		return dependencies[i].Rank < dependencies[j].Rank
+	})
+
+	var summaries []summary
+	for _, recommendation := range []model.Recommendation{model.DecisionMaking, model.Watchlist, model.NoImmediateAction, model.NoConcerns, model.Inconclusive} {
+		summaries = append(summaries, summary{Recommendation: string(recommendation), Count: counts[string(recommendation)]})
+	}
+
+	return page.Execute(w, htmlReport{
+		SchemaVersion: document.SchemaVersion,
+		GeneratedAt:   document.GeneratedAt.Format(time.RFC3339),
+		Total:         len(dependencies),
+		Summary:       summaries,
+		Dependencies:  dependencies,
+	})
+}
+
+func percent(share float64) string {
+	return fmt.Sprintf("%.0f%%", share*100)
+}
+
+func number(value *float64) string {
+	if value == nil {
+		return "n/a"
+	}
+	return fmt.Sprintf("%.4g", *value)
+}
+
+// class maps a recommendation to the css class coloring it
+func class(recommendation string) string {
+	switch model.Recommendation(recommendation) {
+	case model.DecisionMaking:
+		return "dm"
+	case model.Watchlist:
+		return "w"
+	case model.NoImmediateAction:
+		return "nia"
+	case model.NoConcerns:
+		return "nc"
+	default:
+		return "inc"
+	}
+}
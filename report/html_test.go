@@ -0,0 +1,79 @@
+package report
+
+import (
+	"bytes"
+	"github.com/a-grasso/deprec"
+	"github.com/a-grasso/deprec/agent"
+	"github.com/a-grasso/deprec/model"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+func result() *deprec.Result {
+
+	recentness := model.NewCore(model.Recentness)
+	recentness.Measure("Months since last commit", model.GitHubSource).Because("last commit on 2020-03-01").IntakeLimit(31, 24, 4)
+
+	processing := model.NewCore(model.Processing)
+	processing.Measure("Average closing time in months", model.GitHubSource).IntakeLimit(math.Inf(1), 6, 1)
+
+	outdated := model.NewCore(model.CombCon)
+	outdated.Overtake(*recentness, 2)
+	outdated.Overtake(*processing, 1)
+
+	popularity := model.NewCore(model.Popularity)
+	popularity.Measure("Repository popularity", model.GitHubSource).IntakeThreshold(5000, 1000, 1)
+
+	popular := model.NewCore(model.CombCon)
+	popular.Overtake(*popularity, 1)
+
+	empty := model.NewCore(model.CombCon)
+
+	return &deprec.Result{Results: map[string]agent.Result{
+		"a-popular": {Dependency: model.Dependency{Name: "a-popular", Version: "2.0.0"}, Core: *popular, Recommendations: popular.Recommend(), DataSources: []string{"github"}},
+		"left-pad":  {Dependency: model.Dependency{Name: "left-pad", Version: "1.3.0"}, Core: *outdated, Recommendations: outdated.Recommend(), DataSources: []string{"npm", "github"}},
+		"<script>":  {Dependency: model.Dependency{Name: "<script>"}, Core: *empty, Recommendations: empty.Recommend(), Errors: []string{"npm: not found"}},
+	}}
+}
+
+func TestHTML(t *testing.T) {
+
+	var buffer bytes.Buffer
+	assert.NoError(t, HTML(&buffer, result(), time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)))
+
+	html := buffer.String()
+
+	assert.Contains(t, html, "3 dependencies, generated 2023-05-01T12:00:00Z")
+	assert.Contains(t, html, `<div class="dm"><strong>1</strong>Decision Making</div>`)
+	assert.Contains(t, html, `<div class="nc"><strong>1</strong>No Concerns</div>`)
+	assert.Contains(t, html, `<div class="inc"><strong>1</strong>Inconclusive | No Recommendation Was Possible</div>`)
+
+	assert.Less(t, strings.Index(html, ">left-pad<"), strings.Index(html, ">a-popular<"))
+	assert.Contains(t, html, `<span class="dm" style="width: 100%"></span>`)
+	assert.Contains(t, html, "npm, github")
+
+	assert.Contains(t, html, "Months since last commit [github]")
+	assert.Contains(t, html, "31 (limit 24)")
+	assert.Contains(t, html, "n/a (limit 6)")
+	assert.Contains(t, html, "last commit on 2020-03-01")
+	assert.Contains(t, html, "npm: not found")
+
+	assert.Contains(t, html, "&lt;script&gt;")
+	assert.NotContains(t, html, "<script>\n<")
+}
+
+func TestHTMLHasNoExternalAssets(t *testing.T) {
+
+	var buffer bytes.Buffer
+	assert.NoError(t, HTML(&buffer, result(), time.Now()))
+
+	html := buffer.String()
+
+	assert.NotContains(t, html, " src=")
+	assert.NotContains(t, html, "<link")
+	assert.NotContains(t, html, "@import")
+	assert.NotContains(t, html, "url(")
+}
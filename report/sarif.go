@@ -0,0 +1,280 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	cdx "github.com/CycloneDX/cyclonedx-go"
+	"github.com/a-grasso/deprec"
+	"github.com/a-grasso/deprec/explanation"
+	"github.com/a-grasso/deprec/model"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+
+	// number of cores named in a result message
+	strongestCores = 3
+)
+
+type sarifRule struct {
+	recommendation model.Recommendation
+	id             string
+	name           string
+	level          string
+	description    string
+}
+
+// only dependencies with a recommendation asking for action are reported
+var sarifRules = []sarifRule{
+	{model.DecisionMaking, "deprec/decision-making", "DecisionMaking", "error", "The dependency shows strong signs of deprecation, a decision on replacing it should be made."},
+	{model.Watchlist, "deprec/watchlist", "Watchlist", "warning", "The dependency shows signs of deprecation and should be watched."},
+}
+
+// manifests by package url type of the SBOM subject
+var manifests = map[string]string{
+	"maven":  "pom.xml",
+	"npm":    "package.json",
+	"golang": "go.mod",
+}
+
+// manifests by the name of the tool that generated the SBOM, used when the subject has no package url
+var toolManifests = map[string]string{
+	"maven": "pom.xml",
+	"npm":   "package.json",
+	"gomod": "go.mod",
+}
+
+type Sarif struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []SarifRun `json:"runs"`
+}
+
+type SarifRun struct {
+	Tool    SarifTool     `json:"tool"`
+	Results []SarifResult `json:"results"`
+}
+
+type SarifTool struct {
+	Driver SarifDriver `json:"driver"`
+}
+
+type SarifDriver struct {
+	Name           string                `json:"name"`
+	InformationURI string                `json:"informationUri"`
+	Rules          []SarifRuleDescriptor `json:"rules"`
+}
+
+type SarifRuleDescriptor struct {
+	ID                   string            `json:"id"`
+	Name                 string            `json:"name"`
+	ShortDescription     SarifMessage      `json:"shortDescription"`
+	DefaultConfiguration SarifRuleDefaults `json:"defaultConfiguration"`
+}
+
+type SarifRuleDefaults struct {
+	Level string `json:"level"`
+}
+
+type SarifMessage struct {
+	Text string `json:"text"`
+}
+
+type SarifResult struct {
+	RuleID              string             `json:"ruleId"`
+	RuleIndex           int                `json:"ruleIndex"`
+	Level               string             `json:"level"`
+	Message             SarifMessage       `json:"message"`
+	Locations           []SarifLocation    `json:"locations,omitempty"`
+	PartialFingerprints map[string]string  `json:"partialFingerprints"`
+	Properties          map[string]float64 `json:"properties"`
+}
+
+type SarifLocation struct {
+	PhysicalLocation SarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type SarifPhysicalLocation struct {
+	ArtifactLocation SarifArtifactLocation `json:"artifactLocation"`
+}
+
+type SarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+// NewSarif reports every dependency recommended for Decision Making or Watchlist, ordered by dependency name.
+// Results point at the manifest of the SBOM subject if its ecosystem can be told from the SBOM metadata,
+// or else at the SBOM file itself, as code scanning drops results without a location.
+func NewSarif(result *deprec.Result, sbom *cdx.BOM, sbomFile string) Sarif {
+
+	driver := SarifDriver{Name: "deprec", InformationURI: "https://github.com/a-grasso/deprec"}
+	for _, rule := range sarifRules {
+		driver.Rules = append(driver.Rules, SarifRuleDescriptor{
+			ID:                   rule.id,
+			Name:                 rule.name,
+			ShortDescription:     SarifMessage{Text: rule.description},
+			DefaultConfiguration: SarifRuleDefaults{Level: rule.level},
+		})
+	}
+
+	artifact := manifestOf(sbom)
+	if artifact == "" {
+		artifact = filepath.ToSlash(sbomFile)
+	}
+
+	var locations []SarifLocation
+	if artifact != "" {
+		locations = []SarifLocation{{PhysicalLocation: SarifPhysicalLocation{ArtifactLocation: SarifArtifactLocation{URI: artifact}}}}
+	}
+
+	results := []SarifResult{}
+
+	for _, e := range result.Explanations() {
+
+		index := ruleIndex(e.Recommendation)
+		if index < 0 {
+			continue
+		}
+
+		dependency := e.Dependency
+
+		fingerprint := dependency.PackageURL
+		if fingerprint == "" {
+			fingerprint = strings.TrimSpace(fmt.Sprintf("%s %s", dependency.Name, dependency.Version))
+		}
+
+		r := e.Recommendations
+
+		results = append(results, SarifResult{
+			RuleID:              sarifRules[index].id,
+			RuleIndex:           index,
+			Level:               sarifRules[index].level,
+			Message:             SarifMessage{Text: sarifMessage(e)},
+			Locations:           locations,
+			PartialFingerprints: map[string]string{"dependency/v1": fingerprint},
+			Properties: map[string]float64{
+				"decisionMaking":    r[model.DecisionMaking],
+				"watchlist":         r[model.Watchlist],
+				"noImmediateAction": r[model.NoImmediateAction],
+				"noConcerns":        r[model.NoConcerns],
+			},
+		})
+	}
+
+	return Sarif{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs:    []SarifRun{{Tool: SarifTool{Driver: driver}, Results: results}},
+	}
+}
+
+func WriteSarif(w io.Writer, sarif Sarif) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(sarif)
+}
+
+func ruleIndex(recommendation model.Recommendation) int {
+	for i, rule := range sarifRules {
+		if rule.recommendation == recommendation {
+			return i
+		}
+	}
+	return -1
+}
+
+type coreContribution struct {
+	core         model.CoreName
+	contribution float64
+	strongest    explanation.Item
+}
+
+// sarifMessage names the cores contributing most to the concerns, each with its strongest statement
+func sarifMessage(e explanation.Explanation) string {
+
+	byCore := make(map[model.CoreName]*coreContribution)
+	var cores []*coreContribution
+
+	// concerns are ordered by contribution, so the first item of a core is its strongest
+	for _, item := range e.Concerns {
+		c, ok := byCore[item.Statement.Core]
+		if !ok {
+			c = &coreContribution{core: item.Statement.Core, strongest: item}
+			byCore[item.Statement.Core] = c
+			cores = append(cores, c)
+		}
+		c.contribution += item.Contribution
+	}
+
+	sort.SliceStable(cores, func(i, j int) bool {
+		return cores[i].contribution > cores[j].contribution
+	})
+
+	if len(cores) > strongestCores {
+		cores = cores[:strongestCores]
+	}
+
+	name := e.Dependency.Name
+	if e.Dependency.Version != "" {
+		name = fmt.Sprintf("%s %s", name, e.Dependency.Version)
+	}
+
+	message := fmt.Sprintf("Dependency '%s' is rated %s (%.0f%%).", name, e.Recommendation, e.Recommendations[e.Recommendation]*100)
+
+	var reasons []string
+	for _, c := range cores {
+		reasons = append(reasons, fmt.Sprintf("%s (%.0f%%): %s", c.core, c.contribution*100, c.strongest.Text))
+	}
+
+	if len(reasons) != 0 {
+		message += " Strongest contributing cores: " + strings.Join(reasons, "; ") + "."
+	}
+
+	if e.DecisionReason != "" {
+		message += " " + e.DecisionReason
+	}
+
+	if len(e.Successors) != 0 {
+		message += fmt.Sprintf(" Possible successor: %s.", e.Successors[0].Name)
+	}
+
+	return message
+}
+
+// manifestOf guesses the manifest the SBOM was generated from, by the package url of its subject or else by the generating tool
+func manifestOf(sbom *cdx.BOM) string {
+
+	if sbom == nil || sbom.Metadata == nil {
+		return ""
+	}
+
+	metadata := sbom.Metadata
+
+	if metadata.Component != nil && metadata.Component.PackageURL != "" {
+		if purl, err := model.ParsePackageURL(metadata.Component.PackageURL); err == nil {
+			if manifest, ok := manifests[purl.Type]; ok {
+				return manifest
+			}
+		}
+	}
+
+	if metadata.Tools == nil {
+		return ""
+	}
+
+	for _, tool := range *metadata.Tools {
+		name := strings.ToLower(tool.Name)
+		for keyword, manifest := range toolManifests {
+			if strings.Contains(name, keyword) {
+				return manifest
+			}
+		}
+	}
+
+	return ""
+}
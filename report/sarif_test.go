@@ -0,0 +1,64 @@
+package report
+
+import (
+	"bytes"
+	"encoding/json"
+	cdx "github.com/CycloneDX/cyclonedx-go"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewSarif(t *testing.T) {
+
+	sbom := &cdx.BOM{Metadata: &cdx.Metadata{Component: &cdx.Component{PackageURL: "pkg:npm/my-app@1.0.0"}}}
+
+	sarif := NewSarif(result(), sbom, "bom.json")
+
+	assert.Equal(t, "2.1.0", sarif.Version)
+	assert.Len(t, sarif.Runs, 1)
+
+	run := sarif.Runs[0]
+	assert.Len(t, run.Tool.Driver.Rules, 2)
+	assert.Equal(t, "deprec/decision-making", run.Tool.Driver.Rules[0].ID)
+	assert.Equal(t, "warning", run.Tool.Driver.Rules[1].DefaultConfiguration.Level)
+
+	assert.Len(t, run.Results, 1)
+
+	r := run.Results[0]
+	assert.Equal(t, "deprec/decision-making", r.RuleID)
+	assert.Equal(t, 0, r.RuleIndex)
+	assert.Equal(t, "error", r.Level)
+	assert.Equal(t, "package.json", r.Locations[0].PhysicalLocation.ArtifactLocation.URI)
+	assert.Equal(t, "left-pad 1.3.0", r.PartialFingerprints["dependency/v1"])
+	assert.Equal(t, "Dependency 'left-pad 1.3.0' is rated Decision Making (100%). "+
+		"Strongest contributing cores: Recentness (67%): Months since last commit: 31 (limit 24), last commit on 2020-03-01; "+
+		"Processing (33%): Average closing time in months: n/a (limit 6).", r.Message.Text)
+}
+
+func TestManifestOf(t *testing.T) {
+
+	maven := &cdx.BOM{Metadata: &cdx.Metadata{Tools: &[]cdx.Tool{{Vendor: "CycloneDX", Name: "CycloneDX Maven plugin"}}}}
+	gomod := &cdx.BOM{Metadata: &cdx.Metadata{Component: &cdx.Component{PackageURL: "pkg:golang/github.com/a-grasso/deprec"}}}
+	unknown := &cdx.BOM{Metadata: &cdx.Metadata{Component: &cdx.Component{PackageURL: "pkg:pypi/requests@2.0.0"}}}
+
+	assert.Equal(t, "pom.xml", manifestOf(maven))
+	assert.Equal(t, "go.mod", manifestOf(gomod))
+	assert.Equal(t, "", manifestOf(unknown))
+	assert.Equal(t, "", manifestOf(&cdx.BOM{}))
+	assert.Equal(t, "", manifestOf(nil))
+}
+
+func TestWriteSarifFallsBackToSBOMFile(t *testing.T) {
+
+	var buffer bytes.Buffer
+	assert.NoError(t, WriteSarif(&buffer, NewSarif(result(), nil, "sboms/bom.json")))
+
+	var document struct {
+		Schema string `json:"$schema"`
+		Runs   []SarifRun
+	}
+	assert.NoError(t, json.Unmarshal(buffer.Bytes(), &document))
+
+	assert.Equal(t, "https://json.schemastore.org/sarif-2.1.0.json", document.Schema)
+	assert.Equal(t, "sboms/bom.json", document.Runs[0].Results[0].Locations[0].PhysicalLocation.ArtifactLocation.URI)
+}
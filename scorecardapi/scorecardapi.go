@@ -0,0 +1,98 @@
+package scorecardapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/a-grasso/deprec/configuration"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+type Client struct {
+	BaseURLProject string
+	Directory      string
+}
+
+func NewClient(config configuration.Scorecard) *Client {
+
+	baseURL := "https://api.securityscorecards.dev"
+	if config.URL != "" {
+		baseURL = strings.TrimSuffix(config.URL, "/")
+	}
+
+	return &Client{
+		BaseURLProject: baseURL + "/projects/%s/%s/%s",
+		Directory:      config.Directory,
+	}
+}
+
+type Repo struct {
+	Name   string `json:"name"`
+	Commit string `json:"commit"`
+}
+
+type Check struct {
+	Name   string `json:"name"`
+	Score  int    `json:"score"`
+	Reason string `json:"reason"`
+}
+
+// Result is shared by the scorecard api and the json output of the scorecard cli
+type Result struct {
+	Date   string  `json:"date"`
+	Repo   Repo    `json:"repo"`
+	Score  float64 `json:"score"`
+	Checks []Check `json:"checks"`
+}
+
+func (c *Client) GetResult(platform, owner, repo string) (*Result, error) {
+
+	if c.Directory != "" {
+		return c.findResult(fmt.Sprintf("%s/%s/%s", platform, owner, repo))
+	}
+
+	resp, err := http.Get(fmt.Sprintf(c.BaseURLProject, platform, owner, repo))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("scorecard api responded with status %d for '%s/%s'", resp.StatusCode, owner, repo)
+	}
+
+	var result Result
+	err = json.NewDecoder(resp.Body).Decode(&result)
+
+	return &result, err
+}
+
+// findResult looks through locally stored results for the one that was computed for the repository
+func (c *Client) findResult(name string) (*Result, error) {
+
+	files, err := filepath.Glob(filepath.Join(c.Directory, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, file := range files {
+
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		var result Result
+		if err := json.Unmarshal(content, &result); err != nil {
+			continue
+		}
+
+		if strings.EqualFold(result.Repo.Name, name) {
+			return &result, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no scorecard result for '%s' in '%s'", name, c.Directory)
+}
@@ -0,0 +1,39 @@
+package scorecardapi
+
+import (
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const result = `{
+  "date": "2023-01-09",
+  "repo": {"name": "github.com/apache/logging-log4j2", "commit": "3f2b8b0"},
+  "scorecard": {"version": "v4.10.2", "commit": "f3d1ab8"},
+  "score": 6.4,
+  "checks": [
+    {"name": "Maintained", "score": 10, "reason": "30 commit(s) out of 30 and 12 issue activity out of 30 found in the last 90 days -- score normalized to 10"},
+    {"name": "CI-Tests", "score": -1, "reason": "no pull request found"}
+  ]
+}`
+
+func TestGetResultFromDirectory(t *testing.T) {
+
+	directory := t.TempDir()
+	assert.Nil(t, os.WriteFile(filepath.Join(directory, "log4j2.json"), []byte(result), 0o644))
+	assert.Nil(t, os.WriteFile(filepath.Join(directory, "broken.json"), []byte("{"), 0o644))
+
+	client := &Client{Directory: directory}
+
+	r, err := client.GetResult("github.com", "Apache", "logging-log4j2")
+
+	assert.Nil(t, err)
+	assert.Equal(t, 6.4, r.Score)
+	assert.Equal(t, "3f2b8b0", r.Repo.Commit)
+	assert.Len(t, r.Checks, 2)
+	assert.Equal(t, -1, r.Checks[1].Score)
+
+	_, err = client.GetResult("github.com", "apache", "commons-lang")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,36 @@
+package scorecardapi
+
+import (
+	"context"
+	"fmt"
+	"github.com/a-grasso/deprec/cache"
+)
+
+type ClientWrapper struct {
+	Cache  *cache.Cache
+	Client *Client
+}
+
+func NewClientWrapper(client *Client, cache *cache.Cache) *ClientWrapper {
+	return &ClientWrapper{
+		Cache:  cache,
+		Client: client,
+	}
+}
+
+func (cw *ClientWrapper) GetResult(platform, owner, repo string) (*Result, error) {
+
+	// locally stored results are replaced by new scans and must not be shadowed by the cache
+	if cw.Client.Directory != "" {
+		return cw.Client.GetResult(platform, owner, repo)
+	}
+
+	coll := cw.Cache.Database("scorecard_result").Collection(fmt.Sprintf("%s/%s/%s", platform, owner, repo))
+
+	f := func() (*Result, error) {
+		result, err := cw.Client.GetResult(platform, owner, repo)
+		return result, err
+	}
+
+	return cache.FetchSingle[Result](context.TODO(), coll, f)
+}
@@ -0,0 +1,264 @@
+package deprec
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/a-grasso/deprec/agent"
+	"github.com/a-grasso/deprec/model"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// SchemaVersion of the result document, see schema/result.schema.json.
+// The major version changes whenever a field is removed or changes its meaning.
+const SchemaVersion = "1.0"
+
+type ResultDocument struct {
+	SchemaVersion string             `json:"schemaVersion"`
+	GeneratedAt   time.Time          `json:"generatedAt"`
+	Results       []DependencyResult `json:"results"`
+}
+
+type DependencyResult struct {
+	Dependency        DependencyIdentity  `json:"dependency"`
+	TopRecommendation string              `json:"topRecommendation"`
+	Recommendations   RecommendationShare `json:"recommendations"`
+	DecisionReason    string              `json:"decisionReason,omitempty"`
+	Core              CoreNode            `json:"core"`
+	DataSources       []string            `json:"dataSources"`
+	Timings           Timings             `json:"timings"`
+	Errors            []string            `json:"errors"`
+}
+
+type DependencyIdentity struct {
+	Name               string            `json:"name"`
+	Version            string            `json:"version,omitempty"`
+	PackageURL         string            `json:"purl,omitempty"`
+	Hashes             map[string]string `json:"hashes,omitempty"`
+	ExternalReferences map[string]string `json:"externalReferences,omitempty"`
+}
+
+// RecommendationShare holds either the share of each recommendation or, within the core tree, the raw bucket sums
+type RecommendationShare struct {
+	DecisionMaking    float64 `json:"decisionMaking"`
+	Watchlist         float64 `json:"watchlist"`
+	NoImmediateAction float64 `json:"noImmediateAction"`
+	NoConcerns        float64 `json:"noConcerns"`
+}
+
+// CoreNode is a core with the weight it was overtaken with by its parent, the root core has a weight of 0
+type CoreNode struct {
+	Name       string              `json:"name"`
+	Weight     float64             `json:"weight"`
+	Buckets    RecommendationShare `json:"buckets"`
+	Statements []StatementRecord   `json:"statements,omitempty"`
+	Cores      []CoreNode          `json:"cores,omitempty"`
+}
+
+// StatementRecord has a null value for measurements without a finite value, e.g. the closing time when no issue was closed
+type StatementRecord struct {
+	Metric     string   `json:"metric,omitempty"`
+	Value      *float64 `json:"value"`
+	Limit      *float64 `json:"limit,omitempty"`
+	Threshold  *float64 `json:"threshold,omitempty"`
+	Normalized float64  `json:"normalized"`
+	Bucket     string   `json:"bucket"`
+	Weight     float64  `json:"weight"`
+	Source     string   `json:"source,omitempty"`
+	Evidence   string   `json:"evidence,omitempty"`
+}
+
+type Timings struct {
+	ExtractionMillis int64 `json:"extractionMs"`
+	EvaluationMillis int64 `json:"evaluationMs"`
+}
+
+// NewResultDocument converts a run result, dependencies are ordered by name so documents of equal runs are equal
+func NewResultDocument(result *Result, generatedAt time.Time) ResultDocument {
+
+	document := ResultDocument{SchemaVersion: SchemaVersion, GeneratedAt: generatedAt.UTC(), Results: []DependencyResult{}}
+
+	for _, agentResult := range result.Results {
+		document.Results = append(document.Results, newDependencyResult(agentResult))
+	}
+
+	sort.SliceStable(document.Results, func(i, j int) bool {
+		return document.Results[i].Dependency.Name < document.Results[j].Dependency.Name
+	})
+
+	return document
+}
+
+func newDependencyResult(result agent.Result) DependencyResult {
+
+	dependency := result.Dependency
+
+	identity := DependencyIdentity{
+		Name:       dependency.Name,
+		Version:    dependency.Version,
+		PackageURL: dependency.PackageURL,
+	}
+
+	if len(dependency.Hashes) != 0 {
+		identity.Hashes = make(map[string]string)
+		for algorithm, hash := range dependency.Hashes {
+			identity.Hashes[string(algorithm)] = hash
+		}
+	}
+
+	if len(dependency.ExternalReferences) != 0 {
+		identity.ExternalReferences = make(map[string]string)
+		for reference, url := range dependency.ExternalReferences {
+			identity.ExternalReferences[string(reference)] = url
+		}
+	}
+
+	r := result.Recommendations
+
+	dataSources := result.DataSources
+	if dataSources == nil {
+		dataSources = []string{}
+	}
+
+	errs := result.Errors
+	if errs == nil {
+		errs = []string{}
+	}
+
+	return DependencyResult{
+		Dependency:        identity,
+		TopRecommendation: string(result.TopRecommendation()),
+		Recommendations: RecommendationShare{
+			DecisionMaking:    r[model.DecisionMaking],
+			Watchlist:         r[model.Watchlist],
+			NoImmediateAction: r[model.NoImmediateAction],
+			NoConcerns:        r[model.NoConcerns],
+		},
+		DecisionReason: result.DecisionReason,
+		Core:           newCoreNode(result.Core, 0),
+		DataSources:    dataSources,
+		Timings: Timings{
+			ExtractionMillis: result.Timings.Extraction.Milliseconds(),
+			EvaluationMillis: result.Timings.Evaluation.Milliseconds(),
+		},
+		Errors: errs,
+	}
+}
+
+// newCoreNode orders underlying cores by descending weight and then by name, unlike the map they come from
+func newCoreNode(core model.Core, weight float64) CoreNode {
+
+	node := CoreNode{
+		Name:   string(core.Name),
+		Weight: weight,
+		Buckets: RecommendationShare{
+			DecisionMaking:    core.DecisionMaking,
+			Watchlist:         core.Watchlist,
+			NoImmediateAction: core.NoImmediateAction,
+			NoConcerns:        core.NoConcerns,
+		},
+	}
+
+	for _, s := range core.Statements {
+		node.Statements = append(node.Statements, StatementRecord{
+			Metric:     s.Metric,
+			Value:      finite(s.Value),
+			Limit:      s.Limit,
+			Threshold:  s.Threshold,
+			Normalized: s.Normalized,
+			Bucket:     string(s.Bucket),
+			Weight:     s.Weight,
+			Source:     s.Source,
+			Evidence:   s.Evidence,
+		})
+	}
+
+	for w, cores := range core.UnderlyingCores {
+		for _, underlying := range cores {
+			node.Cores = append(node.Cores, newCoreNode(underlying, w))
+		}
+	}
+
+	sort.SliceStable(node.Cores, func(i, j int) bool {
+		if node.Cores[i].Weight != node.Cores[j].Weight {
+			return node.Cores[i].Weight > node.Cores[j].Weight
+		}
+		return node.Cores[i].Name < node.Cores[j].Name
+	})
+
+	return node
+}
+
+// ToCore rebuilds the core tree of a decoded document
+func (n CoreNode) ToCore() model.Core {
+
+	core := model.NewCore(model.CoreName(n.Name))
+
+	core.DecisionMaking = n.Buckets.DecisionMaking
+	core.Watchlist = n.Buckets.Watchlist
+	core.NoImmediateAction = n.Buckets.NoImmediateAction
+	core.NoConcerns = n.Buckets.NoConcerns
+
+	for _, s := range n.Statements {
+
+		value := math.Inf(1)
+		if s.Value != nil {
+			value = *s.Value
+		}
+
+		core.Statements = append(core.Statements, model.Statement{
+			Core:       core.Name,
+			Metric:     s.Metric,
+			Value:      value,
+			Limit:      s.Limit,
+			Threshold:  s.Threshold,
+			Normalized: s.Normalized,
+			Bucket:     model.Recommendation(s.Bucket),
+			Weight:     s.Weight,
+			Source:     s.Source,
+			Evidence:   s.Evidence,
+		})
+	}
+
+	for _, underlying := range n.Cores {
+		core.UnderlyingCores[underlying.Weight] = append(core.UnderlyingCores[underlying.Weight], underlying.ToCore())
+	}
+
+	return *core
+}
+
+func finite(value float64) *float64 {
+	if math.IsInf(value, 0) || math.IsNaN(value) {
+		return nil
+	}
+	return &value
+}
+
+func WriteResultDocument(w io.Writer, document ResultDocument) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(document)
+}
+
+// ReadResultDocument rejects documents of another major schema version
+func ReadResultDocument(r io.Reader) (*ResultDocument, error) {
+
+	var document ResultDocument
+	if err := json.NewDecoder(r).Decode(&document); err != nil {
+		return nil, err
+	}
+
+	if major(document.SchemaVersion) != major(SchemaVersion) {
+		return nil, fmt.Errorf("unsupported result schema version '%s', expected '%s'", document.SchemaVersion, SchemaVersion)
+	}
+
+	return &document, nil
+}
+
+func major(version string) string {
+	major, _, _ := strings.Cut(version, ".")
+	return major
+}
@@ -0,0 +1,115 @@
+package deprec_test
+
+import (
+	"bytes"
+	"flag"
+	"github.com/a-grasso/deprec"
+	"github.com/a-grasso/deprec/agent"
+	"github.com/a-grasso/deprec/model"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+const golden = "testdata/result.golden.json"
+
+func goldenResult() *deprec.Result {
+
+	recentness := model.NewCore(model.Recentness)
+	recentness.Measure("Months since last commit", model.GitHubSource).Because("last commit on 2020-03-01").IntakeLimit(31, 24, 4)
+
+	processing := model.NewCore(model.Processing)
+	processing.Measure("Average closing time in months", model.GitHubSource).IntakeLimit(math.Inf(1), 6, 1)
+
+	popularity := model.NewCore(model.Popularity)
+	popularity.Measure("Repository popularity", model.GitHubSource).IntakeThreshold(5000, 1000, 1)
+
+	// siblings with the same weight used to end up in the same map slot
+	root := model.NewCore(model.CombCon)
+	root.Overtake(*recentness, 2)
+	root.Overtake(*processing, 1)
+	root.Overtake(*popularity, 1)
+
+	leftPad := agent.Result{
+		Dependency: model.Dependency{
+			Name:               "left-pad",
+			Version:            "1.3.0",
+			PackageURL:         "pkg:npm/left-pad@1.3.0",
+			Hashes:             map[model.HashAlgorithm]string{"SHA-1": "5b8a3a7765dfe001261dde915589e782f8c94d1e"},
+			ExternalReferences: map[model.ExternalReference]string{model.VCS: "https://github.com/left-pad/left-pad"},
+		},
+		Core:            *root,
+		Recommendations: root.Recommend(),
+		DataSources:     []string{"ossindex", "npm", "github"},
+		Timings:         agent.Timings{Extraction: 1500 * time.Millisecond, Evaluation: 3 * time.Millisecond},
+	}
+
+	empty := model.NewCore(model.CombCon)
+
+	unknown := agent.Result{
+		Dependency:      model.Dependency{Name: "internal-lib"},
+		Core:            *empty,
+		Recommendations: empty.Recommend(),
+		Errors:          []string{"ossindex: package url is missing type or name"},
+	}
+
+	return &deprec.Result{Results: map[string]agent.Result{"left-pad": leftPad, "internal-lib": unknown}}
+}
+
+func TestResultDocumentGolden(t *testing.T) {
+
+	document := deprec.NewResultDocument(goldenResult(), time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC))
+
+	var buffer bytes.Buffer
+	assert.NoError(t, deprec.WriteResultDocument(&buffer, document))
+
+	if *update {
+		assert.NoError(t, os.WriteFile(golden, buffer.Bytes(), 0644))
+	}
+
+	expected, err := os.ReadFile(golden)
+	assert.NoError(t, err)
+	assert.Equal(t, string(expected), buffer.String())
+}
+
+func TestResultDocumentRoundTrip(t *testing.T) {
+
+	file, err := os.Open(golden)
+	assert.NoError(t, err)
+	defer file.Close()
+
+	document, err := deprec.ReadResultDocument(file)
+	assert.NoError(t, err)
+
+	assert.Len(t, document.Results, 2)
+	assert.Equal(t, "internal-lib", document.Results[0].Dependency.Name)
+	assert.Equal(t, string(model.Inconclusive), document.Results[0].TopRecommendation)
+
+	leftPad := document.Results[1]
+	assert.Equal(t, int64(1500), leftPad.Timings.ExtractionMillis)
+
+	core := leftPad.Core.ToCore()
+	assert.Len(t, core.UnderlyingCores[1], 2)
+	assert.Len(t, core.AllStatements(), 3)
+
+	var buffer bytes.Buffer
+	assert.NoError(t, deprec.WriteResultDocument(&buffer, *document))
+
+	expected, err := os.ReadFile(golden)
+	assert.NoError(t, err)
+	assert.Equal(t, string(expected), buffer.String())
+}
+
+func TestReadResultDocumentRejectsOtherMajorVersion(t *testing.T) {
+
+	_, err := deprec.ReadResultDocument(strings.NewReader(`{"schemaVersion": "2.0", "results": []}`))
+	assert.Error(t, err)
+
+	_, err = deprec.ReadResultDocument(strings.NewReader(`{"schemaVersion": "1.3", "results": []}`))
+	assert.NoError(t, err)
+}
@@ -0,0 +1,87 @@
+package statistics
+
+import (
+	"sort"
+	"time"
+)
+
+const daysPerMonth = 365.25 / 12
+
+type Cadence struct {
+	Releases       int
+	RecentReleases int // within the analysed timeframe
+
+	MedianGap  float64 // months between consecutive releases
+	CurrentGap float64 // months since the latest release
+}
+
+// CurrentOverMedian relates the current silence to the usual silence between releases
+func (c *Cadence) CurrentOverMedian() float64 {
+	if c.MedianGap == 0 {
+		return 0
+	}
+	return c.CurrentGap / c.MedianGap
+}
+
+func AnalyzeCadence[T HasTimestamp](data []T, timeframeMonths int) *Cadence {
+
+	var timestamps []time.Time
+	for _, d := range data {
+		if t := d.GetTimestamp(); !t.IsZero() {
+			timestamps = append(timestamps, t)
+		}
+	}
+
+	if len(timestamps) == 0 {
+		return nil
+	}
+
+	sort.Slice(timestamps, func(i, j int) bool {
+		return timestamps[i].Before(timestamps[j])
+	})
+
+	now := CustomNow()
+	since := now.AddDate(0, -timeframeMonths, 0)
+
+	var gaps []float64
+	recent := 0
+	for i, t := range timestamps {
+		if !t.Before(since) {
+			recent++
+		}
+		if i > 0 {
+			gaps = append(gaps, monthsBetween(timestamps[i-1], t))
+		}
+	}
+
+	return &Cadence{
+		Releases:       len(timestamps),
+		RecentReleases: recent,
+		MedianGap:      Median(gaps),
+		CurrentGap:     monthsBetween(timestamps[len(timestamps)-1], now),
+	}
+}
+
+func monthsBetween(from, to time.Time) float64 {
+	days := to.Sub(from).Hours() / 24
+	if days < 0 {
+		return 0
+	}
+	return days / daysPerMonth
+}
+
+func Median(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+	sort.Float64s(sorted)
+
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[middle-1] + sorted[middle]) / 2
+	}
+	return sorted[middle]
+}
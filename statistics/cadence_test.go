@@ -0,0 +1,52 @@
+package statistics
+
+import (
+	"github.com/a-grasso/deprec/model"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestAnalyzeCadenceRegularReleases(t *testing.T) {
+	now := CustomNow()
+
+	releases := []model.Release{
+		{Version: "1.0.0", Date: now.AddDate(0, -12, 0)},
+		{Version: "1.1.0", Date: now.AddDate(0, -9, 0)},
+		{Version: "1.2.0", Date: now.AddDate(0, -6, 0)},
+		{Version: "1.3.0", Date: now.AddDate(0, -3, 0)},
+	}
+
+	cadence := AnalyzeCadence(releases, 6)
+
+	assert.Equal(t, 4, cadence.Releases)
+	assert.Equal(t, 2, cadence.RecentReleases)
+	assert.InDelta(t, 3, cadence.MedianGap, 0.1)
+	assert.InDelta(t, 3, cadence.CurrentGap, 0.1)
+	assert.InDelta(t, 1, cadence.CurrentOverMedian(), 0.05)
+}
+
+func TestAnalyzeCadenceStalled(t *testing.T) {
+	now := CustomNow()
+
+	releases := []model.Release{
+		{Version: "0.1.0", Date: now.AddDate(-3, 0, 0)},
+		{Version: "0.2.0", Date: now.AddDate(-3, 1, 0)},
+		{Version: "0.3.0", Date: now.AddDate(-3, 2, 0)},
+	}
+
+	cadence := AnalyzeCadence(releases, 12)
+
+	assert.Equal(t, 0, cadence.RecentReleases)
+	assert.InDelta(t, 1, cadence.MedianGap, 0.1)
+	assert.Greater(t, cadence.CurrentOverMedian(), 30.0)
+}
+
+func TestAnalyzeCadenceEmpty(t *testing.T) {
+	assert.Nil(t, AnalyzeCadence([]model.Release{{Version: "1.0.0"}}, 12))
+}
+
+func TestMedian(t *testing.T) {
+	assert.Equal(t, 0.0, Median(nil))
+	assert.Equal(t, 2.0, Median([]float64{3, 1, 2}))
+	assert.Equal(t, 2.5, Median([]float64{4, 1, 2, 3}))
+}
@@ -14,7 +14,7 @@ func TestGetPercentileOf_10elements_50percentile(t *testing.T) {
 	expectedSecond := []int{6, 7, 8, 9, 10}
 	expectedLast := []int{6, 7, 8, 9, 10}
 
-	actualFirst, actualSecond, actualLast := GetPercentilesOf(elements, float64(percentile))
+	actualFirst, actualSecond, actualLast, _ := GetPercentilesOf(elements, float64(percentile))
 
 	assert.EqualValues(t, expectedFirst, actualFirst)
 	assert.EqualValues(t, expectedSecond, actualSecond)
@@ -30,7 +30,7 @@ func TestGetPercentileOf_3elements_20percentile(t *testing.T) {
 	expectedSecond := []int{2}
 	expectedLast := []int{3}
 
-	actualFirst, actualSecond, actualLast := GetPercentilesOf(elements, float64(percentile))
+	actualFirst, actualSecond, actualLast, _ := GetPercentilesOf(elements, float64(percentile))
 
 	assert.EqualValues(t, expectedFirst, actualFirst)
 	assert.EqualValues(t, expectedSecond, actualSecond)
@@ -46,7 +46,7 @@ func TestGetPercentileOf_2elements_20percentile(t *testing.T) {
 	expectedSecond := []int{2}
 	expectedLast := []int{2}
 
-	actualFirst, actualSecond, actualLast := GetPercentilesOf(elements, float64(percentile))
+	actualFirst, actualSecond, actualLast, _ := GetPercentilesOf(elements, float64(percentile))
 
 	assert.EqualValues(t, expectedFirst, actualFirst)
 	assert.EqualValues(t, expectedSecond, actualSecond)
@@ -61,7 +61,7 @@ func TestGetPercentileOf_1elements_20percentile(t *testing.T) {
 	expectedFirst := []int{1}
 	expectedLast := []int{1}
 
-	actualFirst, actualSecond, actualLast := GetPercentilesOf(elements, float64(percentile))
+	actualFirst, actualSecond, actualLast, _ := GetPercentilesOf(elements, float64(percentile))
 
 	assert.EqualValues(t, expectedFirst, actualFirst)
 	assert.Nil(t, nil, actualSecond)
@@ -76,7 +76,7 @@ func TestGetPercentileOf_1elements_50percentile(t *testing.T) {
 	expectedFirst := []int{1}
 	expectedLast := []int{1}
 
-	actualFirst, actualSecond, actualLast := GetPercentilesOf(elements, float64(percentile))
+	actualFirst, actualSecond, actualLast, _ := GetPercentilesOf(elements, float64(percentile))
 
 	assert.EqualValues(t, expectedFirst, actualFirst)
 	assert.Nil(t, nil, actualSecond)
@@ -91,7 +91,7 @@ func TestGetPercentileOf_10elements_2percentile(t *testing.T) {
 	expectedFirst := []int{1}
 	expectedLast := []int{10}
 
-	actualFirst, actualSecond, actualLast := GetPercentilesOf(elements, float64(percentile))
+	actualFirst, actualSecond, actualLast, _ := GetPercentilesOf(elements, float64(percentile))
 
 	assert.EqualValues(t, expectedFirst, actualFirst)
 	assert.Nil(t, nil, actualSecond)
@@ -111,7 +111,7 @@ func TestGetPercentileOf_100elements_2percentile(t *testing.T) {
 	expectedSecond := []int{2, 3}
 	expectedLast := []int{98, 99}
 
-	actualFirst, actualSecond, actualLast := GetPercentilesOf(elements, float64(percentile))
+	actualFirst, actualSecond, actualLast, _ := GetPercentilesOf(elements, float64(percentile))
 
 	assert.EqualValues(t, expectedFirst, actualFirst)
 	assert.EqualValues(t, expectedSecond, actualSecond)
@@ -131,7 +131,7 @@ func TestGetPercentileOf_10elements_30percentile(t *testing.T) {
 	expectedSecond := []int{3, 4, 5}
 	expectedLast := []int{6, 7, 8, 9}
 
-	actualFirst, actualSecond, actualLast := GetPercentilesOf(elements, float64(percentile))
+	actualFirst, actualSecond, actualLast, _ := GetPercentilesOf(elements, float64(percentile))
 
 	assert.EqualValues(t, expectedFirst, actualFirst)
 	assert.EqualValues(t, expectedSecond, actualSecond)
@@ -151,7 +151,7 @@ func TestGetPercentileOf_10elements_33percentile(t *testing.T) {
 	expectedSecond := []int{3, 4, 5}
 	expectedLast := []int{6, 7, 8, 9}
 
-	actualFirst, actualSecond, actualLast := GetPercentilesOf(elements, float64(percentile))
+	actualFirst, actualSecond, actualLast, _ := GetPercentilesOf(elements, float64(percentile))
 
 	assert.EqualValues(t, expectedFirst, actualFirst)
 	assert.EqualValues(t, expectedSecond, actualSecond)
@@ -171,7 +171,7 @@ func TestGetPercentileOf_10elements_34percentile(t *testing.T) {
 	expectedSecond := []int{3, 4, 5}
 	expectedLast := []int{6, 7, 8, 9}
 
-	actualFirst, actualSecond, actualLast := GetPercentilesOf(elements, float64(percentile))
+	actualFirst, actualSecond, actualLast, _ := GetPercentilesOf(elements, float64(percentile))
 
 	assert.EqualValues(t, expectedFirst, actualFirst)
 	assert.EqualValues(t, expectedSecond, actualSecond)
@@ -191,7 +191,7 @@ func TestGetPercentileOf_10elements_20percentile(t *testing.T) {
 	expectedSecond := []int{2, 3}
 	expectedLast := []int{8, 9}
 
-	actualFirst, actualSecond, actualLast := GetPercentilesOf(elements, float64(percentile))
+	actualFirst, actualSecond, actualLast, _ := GetPercentilesOf(elements, float64(percentile))
 
 	assert.EqualValues(t, expectedFirst, actualFirst)
 	assert.EqualValues(t, expectedSecond, actualSecond)
@@ -211,7 +211,7 @@ func TestGetPercentileOf_10elements_60percentile(t *testing.T) {
 	expectedSecond := []int{5, 6, 7, 8, 9}
 	expectedLast := []int{5, 6, 7, 8, 9}
 
-	actualFirst, actualSecond, actualLast := GetPercentilesOf(elements, float64(percentile))
+	actualFirst, actualSecond, actualLast, _ := GetPercentilesOf(elements, float64(percentile))
 
 	assert.EqualValues(t, expectedFirst, actualFirst)
 	assert.EqualValues(t, expectedSecond, actualSecond)
@@ -231,7 +231,7 @@ func TestGetPercentileOf_10elements_40percentile(t *testing.T) {
 	expectedSecond := []int{3, 4, 5}
 	expectedLast := []int{6, 7, 8, 9}
 
-	actualFirst, actualSecond, actualLast := GetPercentilesOf(elements, float64(percentile))
+	actualFirst, actualSecond, actualLast, _ := GetPercentilesOf(elements, float64(percentile))
 
 	assert.EqualValues(t, expectedFirst, actualFirst)
 	assert.EqualValues(t, expectedSecond, actualSecond)
@@ -251,7 +251,7 @@ func TestGetPercentileOf_10elements_25percentile(t *testing.T) {
 	expectedSecond := []int{2, 3, 4}
 	expectedLast := []int{7, 8, 9}
 
-	actualFirst, actualSecond, actualLast := GetPercentilesOf(elements, float64(percentile))
+	actualFirst, actualSecond, actualLast, _ := GetPercentilesOf(elements, float64(percentile))
 
 	assert.EqualValues(t, expectedFirst, actualFirst)
 	assert.EqualValues(t, expectedSecond, actualSecond)
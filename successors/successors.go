@@ -0,0 +1,195 @@
+package successors
+
+import (
+	"fmt"
+	"github.com/a-grasso/deprec/model"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var confidences = map[model.SuccessorSource]float64{
+	model.KnowledgeBaseEntry: 1,
+	model.MavenRelocation:    1,
+	model.RegistryDeprecated: 0.8,
+	model.RepositoryRedirect: 0.7,
+	model.ReadMeMention:      0.5,
+}
+
+const name = `(?:\[([^\]]+)\]\(([^)\s]+)\)|` + "`([^`]+)`" + `|([@\w][\w@/.:\-]*[\w]))`
+
+var phrases = []*regexp.Regexp{
+	regexp.MustCompile(`(?i)\buse\s+` + name + `\s+instead\b`),
+	regexp.MustCompile(`(?i)\b(?:moved|migrated|relocated|renamed)\s+to\s+` + name),
+	regexp.MustCompile(`(?i)\b(?:replaced|superseded)\s+by\s+` + name),
+	regexp.MustCompile(`(?i)\bin\s+favou?r\s+of\s+` + name),
+	regexp.MustCompile(`(?i)\bmigrate\s+to\s+` + name),
+	regexp.MustCompile(`(?i)\bsuccessor(?:\s+project)?(?:\s+is|\s*:)\s+` + name),
+}
+
+// characters a bare word needs to be taken for a package name in prose, e.g. 'acme/new-lib' or '@acme/fetch', but not 'Java'
+const packageCharacters = "/@:-._"
+
+var stopwords = []string{"a", "an", "the", "this", "that", "it", "its", "our", "new", "another", "other", "version", "here", "github", "maven", "npm"}
+
+type hint struct {
+	candidate string
+	evidence  model.SuccessorEvidence
+}
+
+func Detect(dependency model.Dependency, m model.DataModel) []model.Successor {
+
+	var hints []hint
+
+	if knowledge := m.Knowledge; knowledge != nil && knowledge.Successor != "" {
+		hints = append(hints, hint{
+			candidate: knowledge.Successor,
+			evidence:  model.SuccessorEvidence{Source: model.KnowledgeBaseEntry, Snippet: knowledge.DecisionReason()},
+		})
+	}
+
+	if m.Distribution != nil && m.Distribution.Artifact != nil {
+		artifact := m.Distribution.Artifact
+
+		if relocation := artifact.Relocation; relocation != nil {
+			hints = append(hints, hint{
+				candidate: relocation.Coordinates(),
+				evidence:  model.SuccessorEvidence{Source: model.MavenRelocation, Snippet: fmt.Sprintf("<relocation> %s", relocation.Coordinates())},
+			})
+		}
+
+		hints = append(hints, fromText(artifact.DeprecationMessage, model.RegistryDeprecated, false)...)
+	}
+
+	if m.Repository != nil && m.Repository.RepositoryData != nil {
+		repository := m.Repository.RepositoryData
+
+		if repository.MovedTo != "" {
+			hints = append(hints, hint{
+				candidate: repository.MovedTo,
+				evidence:  model.SuccessorEvidence{Source: model.RepositoryRedirect, Snippet: fmt.Sprintf("repository redirects to github.com/%s", repository.MovedTo)},
+			})
+		}
+
+		hints = append(hints, fromText(repository.ReadMe, model.ReadMeMention, true)...)
+	}
+
+	return rank(dependency, hints)
+}
+
+// fromText takes bare words only if they look like a package name when strict, registry messages name packages anyway
+func fromText(text string, source model.SuccessorSource, strict bool) []hint {
+
+	var result []hint
+
+	for _, phrase := range phrases {
+		for _, match := range phrase.FindAllStringSubmatch(text, -1) {
+
+			candidate := candidateOf(match[1:], strict)
+			if candidate == "" {
+				continue
+			}
+
+			result = append(result, hint{
+				candidate: candidate,
+				evidence:  model.SuccessorEvidence{Source: source, Snippet: strings.TrimSpace(match[0])},
+			})
+		}
+	}
+
+	return result
+}
+
+// groups are: link text, link target, code span, bare word
+func candidateOf(groups []string, strict bool) string {
+
+	if strict && groups[0] == "" && groups[2] == "" && !strings.ContainsAny(groups[3], packageCharacters) {
+		return ""
+	}
+
+	candidate := groups[3]
+	if groups[2] != "" {
+		candidate = groups[2]
+	}
+	if groups[0] != "" {
+		candidate = groups[0]
+		if isStopword(candidate) {
+			candidate = groups[1]
+		}
+	}
+
+	candidate = strings.TrimPrefix(candidate, "https://")
+	candidate = strings.TrimPrefix(candidate, "http://")
+	candidate = strings.TrimPrefix(candidate, "github.com/")
+	candidate = strings.TrimSuffix(candidate, ".git")
+	candidate = strings.Trim(candidate, ".,;:)('\"")
+
+	if candidate == "" || isStopword(candidate) {
+		return ""
+	}
+
+	return candidate
+}
+
+func isStopword(word string) bool {
+	lower := strings.ToLower(word)
+	for _, stopword := range stopwords {
+		if lower == stopword {
+			return true
+		}
+	}
+	return false
+}
+
+func rank(dependency model.Dependency, hints []hint) []model.Successor {
+
+	var order []string
+	successors := make(map[string]*model.Successor)
+	misses := make(map[string]float64)
+
+	for _, h := range hints {
+
+		key := strings.ToLower(h.candidate)
+		if key == strings.ToLower(dependency.Name) {
+			continue
+		}
+
+		successor, exists := successors[key]
+		if !exists {
+			successor = &model.Successor{Name: h.candidate}
+			successors[key] = successor
+			misses[key] = 1
+			order = append(order, key)
+		}
+
+		if containsEvidence(successor.Evidence, h.evidence) {
+			continue
+		}
+
+		successor.Evidence = append(successor.Evidence, h.evidence)
+
+		// independent hints strengthen each other without exceeding 1
+		misses[key] *= 1 - confidences[h.evidence.Source]
+		successor.Score = 1 - misses[key]
+	}
+
+	var result []model.Successor
+	for _, key := range order {
+		result = append(result, *successors[key])
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Score > result[j].Score
+	})
+
+	return result
+}
+
+func containsEvidence(evidence []model.SuccessorEvidence, e model.SuccessorEvidence) bool {
+	for _, other := range evidence {
+		if other == e {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,76 @@
+package successors
+
+import (
+	"github.com/a-grasso/deprec/model"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var testDependency = model.Dependency{Name: "old-lib"}
+
+func TestDetectReadMePhrases(t *testing.T) {
+
+	m := model.DataModel{Repository: &model.Repository{RepositoryData: &model.RepositoryData{
+		ReadMe: "# old-lib\n**This project is no longer maintained, use `new-lib` instead.**\nIt has been superseded by [new-lib](https://github.com/acme/new-lib).",
+	}}}
+
+	successors := Detect(testDependency, m)
+
+	assert.Len(t, successors, 1)
+	assert.Equal(t, "new-lib", successors[0].Name)
+	assert.Len(t, successors[0].Evidence, 2)
+	assert.InDelta(t, 0.75, successors[0].Score, 0.0001)
+}
+
+func TestDetectIgnoresStopwords(t *testing.T) {
+
+	m := model.DataModel{Repository: &model.Repository{RepositoryData: &model.RepositoryData{
+		ReadMe: "The docs moved to the wiki. Migrate to this version as soon as possible.",
+	}}}
+
+	assert.Empty(t, Detect(testDependency, m))
+}
+
+func TestDetectReadMeRequiresPackageNames(t *testing.T) {
+
+	m := model.DataModel{Repository: &model.Repository{RepositoryData: &model.RepositoryData{
+		ReadMe: "The build migrated to Java 11. Use Gradle instead of Ant. The code moved to acme/new-lib.",
+	}}}
+
+	successors := Detect(testDependency, m)
+
+	assert.Len(t, successors, 1)
+	assert.Equal(t, "acme/new-lib", successors[0].Name)
+}
+
+func TestDetectRanksRelocationFirst(t *testing.T) {
+
+	m := model.DataModel{
+		Repository: &model.Repository{RepositoryData: &model.RepositoryData{
+			MovedTo: "acme/other-lib",
+		}},
+		Distribution: &model.Distribution{Artifact: &model.Artifact{
+			Relocation: &model.Relocation{GroupID: "org.acme", ArtifactID: "new-lib"},
+		}},
+	}
+
+	successors := Detect(testDependency, m)
+
+	assert.Len(t, successors, 2)
+	assert.Equal(t, "org.acme:new-lib", successors[0].Name)
+	assert.Equal(t, model.MavenRelocation, successors[0].Evidence[0].Source)
+	assert.Equal(t, "acme/other-lib", successors[1].Name)
+}
+
+func TestDetectRegistryDeprecationMessage(t *testing.T) {
+
+	m := model.DataModel{Distribution: &model.Distribution{Artifact: &model.Artifact{
+		DeprecationMessage: "request has been deprecated, use @acme/fetch instead",
+	}}}
+
+	successors := Detect(testDependency, m)
+
+	assert.Len(t, successors, 1)
+	assert.Equal(t, "@acme/fetch", successors[0].Name)
+	assert.Equal(t, model.RegistryDeprecated, successors[0].Evidence[0].Source)
+}
@@ -0,0 +1,36 @@
+package versioning
+
+import "time"
+
+// Release is a version together with its publication date
+type Release struct {
+	Version Version
+	Date    time.Time
+}
+
+// LastOfLine finds the highest version and the latest publication date within a release line, invalid versions and pre-releases are left out
+func LastOfLine(releases []Release, line string, depth int) (Version, time.Time, bool) {
+
+	var latest Version
+	var last time.Time
+	found := false
+
+	for _, release := range releases {
+
+		v := release.Version
+		if !v.IsValid() || v.IsPreRelease() || v.Line(depth) != line {
+			continue
+		}
+
+		if !found || v.Compare(latest) > 0 {
+			latest = v
+		}
+		if release.Date.After(last) {
+			last = release.Date
+		}
+
+		found = true
+	}
+
+	return latest, last, found
+}
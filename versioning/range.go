@@ -0,0 +1,146 @@
+package versioning
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+type comparator struct {
+	operator string
+	version  Version
+}
+
+func (c comparator) matches(v Version) bool {
+
+	compared := v.Compare(c.version)
+
+	switch c.operator {
+	case "<":
+		return compared < 0
+	case "<=":
+		return compared <= 0
+	case ">":
+		return compared > 0
+	case ">=":
+		return compared >= 0
+	case "!=":
+		return compared != 0
+	default:
+		return compared == 0
+	}
+}
+
+// Range is a union of alternatives, each of which is an intersection of comparators
+type Range struct {
+	Original     string
+	alternatives [][]comparator
+}
+
+var comparatorPattern = regexp.MustCompile(`(>=|<=|!=|>|<|==|=)?\s*([^\s,<>=!]+)`)
+
+// ParseRange understands comparator ranges like '>= 1.0, < 2.0' or '<1.2.3 || >=2.0.0'
+// as used by npm and GitHub advisories, as well as maven intervals like '[1.0,2.0)' or '(,1.2.17]'.
+// An empty range or '*' contains every version.
+func ParseRange(r string) (*Range, error) {
+
+	result := &Range{Original: r}
+
+	for _, alternative := range strings.Split(r, "||") {
+
+		alternative = strings.TrimSpace(alternative)
+
+		if alternative == "" || alternative == "*" {
+			result.alternatives = append(result.alternatives, nil)
+			continue
+		}
+
+		if strings.HasPrefix(alternative, "[") || strings.HasPrefix(alternative, "(") {
+			intervals, err := parseIntervals(alternative)
+			if err != nil {
+				return nil, err
+			}
+			result.alternatives = append(result.alternatives, intervals...)
+			continue
+		}
+
+		var comparators []comparator
+		for _, match := range comparatorPattern.FindAllStringSubmatch(alternative, -1) {
+			comparators = append(comparators, comparator{operator: match[1], version: Parse(match[2])})
+		}
+
+		if len(comparators) == 0 {
+			return nil, fmt.Errorf("could not parse version range '%s'", r)
+		}
+
+		result.alternatives = append(result.alternatives, comparators)
+	}
+
+	return result, nil
+}
+
+var intervalPattern = regexp.MustCompile(`([\[(])\s*([^,\])]*?)\s*(?:(,)\s*([^\])]*?)\s*)?([\])])`)
+
+// parseIntervals handles one or more comma separated maven intervals, each interval is an alternative
+func parseIntervals(r string) ([][]comparator, error) {
+
+	matches := intervalPattern.FindAllStringSubmatch(r, -1)
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("could not parse version interval '%s'", r)
+	}
+
+	var result [][]comparator
+
+	for _, match := range matches {
+
+		opening, lower, comma, upper, closing := match[1], match[2], match[3], match[4], match[5]
+
+		// '[1.2]' pins exactly one version
+		if comma == "" {
+			result = append(result, []comparator{{operator: "=", version: Parse(lower)}})
+			continue
+		}
+
+		var comparators []comparator
+		if lower != "" {
+			operator := ">="
+			if opening == "(" {
+				operator = ">"
+			}
+			comparators = append(comparators, comparator{operator: operator, version: Parse(lower)})
+		}
+		if upper != "" {
+			operator := "<="
+			if closing == ")" {
+				operator = "<"
+			}
+			comparators = append(comparators, comparator{operator: operator, version: Parse(upper)})
+		}
+
+		result = append(result, comparators)
+	}
+
+	return result, nil
+}
+
+func (r *Range) Contains(version string) bool {
+
+	v := Parse(version)
+
+	for _, comparators := range r.alternatives {
+
+		contained := true
+		for _, c := range comparators {
+			if !c.matches(v) {
+				contained = false
+				break
+			}
+		}
+
+		if contained {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,265 @@
+package versioning
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type Version struct {
+	Original  string
+	Numbers   []int
+	Qualifier string
+
+	// hyphenated qualifiers such as '-next.1' are pre-releases, as in semver
+	hyphenated bool
+}
+
+// qualifiers ordered like maven's ComparableVersion, releases rank as the empty qualifier
+var qualifierRanks = map[string]int{
+	"alpha":     0,
+	"a":         0,
+	"beta":      1,
+	"b":         1,
+	"milestone": 2,
+	"m":         2,
+	"rc":        3,
+	"cr":        3,
+	"snapshot":  4,
+	"":          5,
+	"ga":        5,
+	"final":     5,
+	"release":   5,
+	"sp":        6,
+}
+
+func Parse(v string) Version {
+
+	result := Version{Original: v}
+
+	s := strings.TrimSpace(v)
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "v"), "V")
+
+	for s != "" {
+		i := strings.IndexFunc(s, func(r rune) bool { return !unicode.IsDigit(r) })
+		if i == 0 {
+			break
+		}
+		if i < 0 {
+			i = len(s)
+		}
+
+		number, err := strconv.Atoi(s[:i])
+		if err != nil {
+			break
+		}
+		result.Numbers = append(result.Numbers, number)
+		s = s[i:]
+
+		if !strings.HasPrefix(s, ".") || len(s) < 2 || !unicode.IsDigit(rune(s[1])) {
+			break
+		}
+		s = s[1:]
+	}
+
+	result.Qualifier = strings.ToLower(strings.TrimLeft(s, ".-_+"))
+	result.hyphenated = strings.HasPrefix(s, "-")
+
+	return result
+}
+
+var dotted = regexp.MustCompile(`\d+(?:\.\d+)+`)
+
+// ParseTag finds the version within tag or release names such as 'log4j-1.2.17' or 'Release 2.0'
+func ParseTag(tag string) Version {
+
+	location := dotted.FindStringIndex(tag)
+	if location == nil {
+		return Parse(tag)
+	}
+
+	v := Parse(tag[location[0]:])
+	v.Original = tag
+
+	return v
+}
+
+// Line identifies the release line of a version by its leading numbers, e.g. '2.17' for depth 2
+func (v Version) Line(depth int) string {
+
+	if depth < 1 {
+		depth = 1
+	}
+
+	var segments []string
+	for i := 0; i < depth; i++ {
+		segments = append(segments, strconv.Itoa(v.segment(i)))
+	}
+
+	return strings.Join(segments, ".")
+}
+
+func (v Version) segment(i int) int {
+	if i < len(v.Numbers) {
+		return v.Numbers[i]
+	}
+	return 0
+}
+
+func (v Version) Major() int {
+	return v.segment(0)
+}
+
+func (v Version) Minor() int {
+	return v.segment(1)
+}
+
+func (v Version) Patch() int {
+	return v.segment(2)
+}
+
+func (v Version) IsValid() bool {
+	return len(v.Numbers) != 0
+}
+
+func (v Version) IsPreRelease() bool {
+	return v.rank() < qualifierRanks[""]
+}
+
+func Compare(a, b string) int {
+	return Parse(a).Compare(Parse(b))
+}
+
+func (v Version) Compare(other Version) int {
+
+	length := len(v.Numbers)
+	if len(other.Numbers) > length {
+		length = len(other.Numbers)
+	}
+
+	for i := 0; i < length; i++ {
+		if c := compareInts(v.segment(i), other.segment(i)); c != 0 {
+			return c
+		}
+	}
+
+	rank := v.rank()
+	if c := compareInts(rank, other.rank()); c != 0 {
+		return c
+	}
+
+	if rank == qualifierRanks[""] {
+		return 0
+	}
+
+	return compareQualifiers(v.Qualifier, other.Qualifier)
+}
+
+func (v Version) rank() int {
+
+	name := strings.TrimRightFunc(v.Qualifier, func(r rune) bool { return unicode.IsDigit(r) || r == '.' || r == '-' })
+
+	if rank, known := qualifierRanks[name]; known {
+		return rank
+	}
+
+	// unknown qualifiers after a '-' such as 'next', 'canary' or 'dev' are pre-releases and precede the known ones
+	if v.hyphenated {
+		return qualifierRanks["alpha"] - 1
+	}
+
+	// other unknown qualifiers are considered after releases, like maven does
+	return qualifierRanks["sp"]
+}
+
+// compares qualifiers of the same rank, e.g. 'rc1' and 'rc10' by their numeric suffix
+func compareQualifiers(a, b string) int {
+
+	suffix := func(q string) (string, int) {
+		i := strings.LastIndexFunc(q, func(r rune) bool { return !unicode.IsDigit(r) })
+		number, err := strconv.Atoi(q[i+1:])
+		if err != nil {
+			return q, 0
+		}
+		return q[:i+1], number
+	}
+
+	nameA, numberA := suffix(a)
+	nameB, numberB := suffix(b)
+
+	if c := strings.Compare(nameA, nameB); c != 0 {
+		return c
+	}
+
+	return compareInts(numberA, numberB)
+}
+
+func compareInts(a, b int) int {
+	if a < b {
+		return -1
+	}
+	if a > b {
+		return 1
+	}
+	return 0
+}
+
+type Distance struct {
+	Majors  int
+	Minors  int
+	Patches int
+}
+
+// DistanceBetween counts majors, minors within the same major and patches within the same minor
+func DistanceBetween(used, latest string) Distance {
+
+	u := Parse(used)
+	l := Parse(latest)
+
+	if !u.IsValid() || !l.IsValid() || u.Compare(l) >= 0 {
+		return Distance{}
+	}
+
+	if majors := l.Major() - u.Major(); majors > 0 {
+		return Distance{Majors: majors}
+	}
+
+	if minors := l.Minor() - u.Minor(); minors > 0 {
+		return Distance{Minors: minors}
+	}
+
+	return Distance{Patches: l.Patch() - u.Patch()}
+}
+
+// Latest returns the highest version, ignoring pre-releases unless there is nothing else
+func Latest(versions []string) string {
+
+	var latest, latestPreRelease *Version
+
+	for _, version := range versions {
+		v := Parse(version)
+		if !v.IsValid() {
+			continue
+		}
+
+		if v.IsPreRelease() {
+			if latestPreRelease == nil || v.Compare(*latestPreRelease) > 0 {
+				latestPreRelease = &v
+			}
+			continue
+		}
+
+		if latest == nil || v.Compare(*latest) > 0 {
+			latest = &v
+		}
+	}
+
+	if latest != nil {
+		return latest.Original
+	}
+	if latestPreRelease != nil {
+		return latestPreRelease.Original
+	}
+	return ""
+}
@@ -0,0 +1,155 @@
+package versioning
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	v := Parse("v5.3.27.RELEASE")
+
+	assert.Equal(t, []int{5, 3, 27}, v.Numbers)
+	assert.Equal(t, "release", v.Qualifier)
+	assert.False(t, v.IsPreRelease())
+}
+
+func TestCompareNumeric(t *testing.T) {
+	assert.Equal(t, -1, Compare("1.2.9", "1.2.10"))
+	assert.Equal(t, 1, Compare("2.0", "1.99.99"))
+	assert.Equal(t, 0, Compare("1.0", "1.0.0"))
+}
+
+func TestCompareQualifiers(t *testing.T) {
+	assert.Equal(t, -1, Compare("1.0.0-alpha", "1.0.0-beta"))
+	assert.Equal(t, -1, Compare("1.0.0-RC1", "1.0.0"))
+	assert.Equal(t, -1, Compare("1.0.0-rc2", "1.0.0-rc10"))
+	assert.Equal(t, -1, Compare("1.0.0-SNAPSHOT", "1.0.0"))
+	assert.Equal(t, 0, Compare("2.0.0.Final", "2.0.0"))
+	assert.Equal(t, 1, Compare("1.0.0-sp1", "1.0.0"))
+	assert.Equal(t, -1, Compare("1.0.0-next.1", "1.0.0"))
+	assert.Equal(t, -1, Compare("2.0.0-canary.3", "2.0.0"))
+	assert.Equal(t, -1, Compare("2.0.0-dev", "2.0.0-alpha"))
+	assert.Equal(t, -1, Compare("1.0.0-next.1", "1.0.0-next.2"))
+	assert.True(t, Parse("1.0.0-next.1").IsPreRelease())
+	assert.True(t, Parse("2.0.0-canary.3").IsPreRelease())
+	assert.True(t, Parse("3.1.0-dev").IsPreRelease())
+}
+
+func TestDistanceBetween(t *testing.T) {
+	assert.Equal(t, Distance{Majors: 1}, DistanceBetween("1.2.17", "2.20.0"))
+	assert.Equal(t, Distance{Minors: 3}, DistanceBetween("2.17.1", "2.20.0"))
+	assert.Equal(t, Distance{Patches: 2}, DistanceBetween("2.20.0", "2.20.2"))
+	assert.Equal(t, Distance{}, DistanceBetween("2.20.2", "2.20.2"))
+	assert.Equal(t, Distance{}, DistanceBetween("3.0.0", "2.20.2"))
+	assert.Equal(t, Distance{}, DistanceBetween("stable", "2.20.2"))
+}
+
+func TestLatest(t *testing.T) {
+	assert.Equal(t, "2.20.0", Latest([]string{"1.2.17", "2.20.0", "2.19.0", "3.0.0-beta1"}))
+	assert.Equal(t, "3.0.0-beta1", Latest([]string{"3.0.0-alpha1", "3.0.0-beta1"}))
+	assert.Equal(t, "1.0.0", Latest([]string{"1.0.0", "1.0.0-next.1"}))
+	assert.Equal(t, "2.0.0-canary.3", Latest([]string{"2.0.0-canary.2", "2.0.0-canary.3"}))
+	assert.Equal(t, "", Latest(nil))
+}
+
+func TestParseTag(t *testing.T) {
+	assert.Equal(t, []int{1, 2, 17}, ParseTag("log4j-1.2.17").Numbers)
+	assert.Equal(t, []int{2, 0}, ParseTag("Release 2.0-rc1").Numbers)
+	assert.Equal(t, "rc1", ParseTag("Release 2.0-rc1").Qualifier)
+	assert.Equal(t, []int{3}, ParseTag("v3").Numbers)
+}
+
+func TestLine(t *testing.T) {
+	assert.Equal(t, "1", Parse("1.2.17").Line(1))
+	assert.Equal(t, "1.2", Parse("1.2.17").Line(2))
+	assert.Equal(t, "2.0", Parse("2").Line(2))
+}
+
+func TestRangeComparators(t *testing.T) {
+	r, err := ParseRange(">= 1.0, < 2.0")
+	assert.Nil(t, err)
+	assert.True(t, r.Contains("1.0"))
+	assert.True(t, r.Contains("1.9.9"))
+	assert.False(t, r.Contains("2.0.0"))
+	assert.False(t, r.Contains("0.9"))
+
+	r, err = ParseRange("<1.2.3 || >=3.0.0")
+	assert.Nil(t, err)
+	assert.True(t, r.Contains("1.2.2"))
+	assert.False(t, r.Contains("2.0.0"))
+	assert.True(t, r.Contains("3.1.0"))
+
+	r, err = ParseRange("= 2.17.0")
+	assert.Nil(t, err)
+	assert.True(t, r.Contains("2.17"))
+	assert.False(t, r.Contains("2.17.1"))
+}
+
+func TestRangeIntervals(t *testing.T) {
+	r, err := ParseRange("[1.0,2.0)")
+	assert.Nil(t, err)
+	assert.True(t, r.Contains("1.0"))
+	assert.False(t, r.Contains("2.0"))
+
+	r, err = ParseRange("(,1.2.17]")
+	assert.Nil(t, err)
+	assert.True(t, r.Contains("1.2.17"))
+	assert.True(t, r.Contains("0.1"))
+	assert.False(t, r.Contains("1.2.18"))
+
+	r, err = ParseRange("[1.2]")
+	assert.Nil(t, err)
+	assert.True(t, r.Contains("1.2.0"))
+	assert.False(t, r.Contains("1.3"))
+
+	r, err = ParseRange("[1.0,1.5),(2.0,)")
+	assert.Nil(t, err)
+	assert.True(t, r.Contains("1.4"))
+	assert.False(t, r.Contains("1.7"))
+	assert.True(t, r.Contains("2.1"))
+}
+
+func TestRangeAny(t *testing.T) {
+	for _, any := range []string{"", "*"} {
+		r, err := ParseRange(any)
+		assert.Nil(t, err)
+		assert.True(t, r.Contains("0.0.1"))
+	}
+
+	_, err := ParseRange(">=")
+	assert.NotNil(t, err)
+}
+
+func TestLastOfLine(t *testing.T) {
+
+	date := func(month int) time.Time { return time.Date(2022, time.Month(month), 1, 0, 0, 0, 0, time.UTC) }
+
+	releases := []Release{
+		{Parse("2.1.0"), date(1)},
+		{Parse("2.1.3"), date(3)},
+		{Parse("2.2.0-rc1"), date(9)},
+		{Parse("2.0.9"), date(6)},
+		{Parse("3.0.0"), date(8)},
+	}
+
+	tests := []struct {
+		line   string
+		depth  int
+		latest string
+		last   time.Time
+		found  bool
+	}{
+		{"2", 1, "2.1.3", date(6), true},
+		{"2.1", 2, "2.1.3", date(3), true},
+		{"2.2", 2, "", time.Time{}, false},
+		{"3", 1, "3.0.0", date(8), true},
+	}
+
+	for _, test := range tests {
+		latest, last, found := LastOfLine(releases, test.line, test.depth)
+		assert.Equal(t, test.found, found, test.line)
+		assert.Equal(t, test.latest, latest.Original, test.line)
+		assert.Equal(t, test.last, last, test.line)
+	}
+}